@@ -24,6 +24,105 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/health": {
+            "get": {
+                "description": "检查所有命名Redis目标的连通性，集群目标同时返回哈希槽分布",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "健康检查",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/breakers": {
+            "get": {
+                "description": "返回每个命名Redis目标以及正在使用的直连地址的熔断器状态",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "熔断器状态",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/meta/errors": {
+            "get": {
+                "description": "返回所有已注册的错误码及其模块、各语言消息、HTTP状态码和可重试性，以及各模块的号段",
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Meta"
+                ],
+                "summary": "错误码目录",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/errors.Catalog"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/metrics": {
+            "get": {
+                "description": "以Prometheus文本格式输出运行指标",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Metrics endpoint",
+                "responses": {
+                    "200": {
+                        "description": "Prometheus指标",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/ping": {
             "get": {
                 "description": "Ping服务，用于健康检查",
@@ -48,19 +147,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/redis/hash/hdel": {
+        "/redis/bitmap/bitcount": {
             "post": {
-                "description": "删除哈希表中一个或多个字段",
+                "description": "统计位图中值为1的位数，可按BYTE或BIT指定范围",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HDEL操作",
+                "summary": "Redis位图BITCOUNT操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -68,7 +168,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHDelRequest"
+                            "$ref": "#/definitions/types.BitmapBitCountRequest"
                         }
                     }
                 ],
@@ -90,23 +190,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hexists": {
+        "/redis/bitmap/bitfield": {
             "post": {
-                "description": "查看哈希表的指定字段是否存在",
+                "description": "对位图执行按类型的GET、SET、INCRBY子操作，支持OVERFLOW WRAP/SAT/FAIL",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HEXISTS操作",
+                "summary": "Redis位图BITFIELD操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -114,7 +221,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHExistsRequest"
+                            "$ref": "#/definitions/types.BitmapBitFieldRequest"
                         }
                     }
                 ],
@@ -136,23 +243,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hget": {
+        "/redis/bitmap/bitfield_ro": {
             "post": {
-                "description": "获取哈希表中指定字段的值",
+                "description": "对位图执行只读的GET子操作（需要Redis 6.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HGET操作",
+                "summary": "Redis位图BITFIELD_RO操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -160,7 +274,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHGetRequest"
+                            "$ref": "#/definitions/types.BitmapBitFieldRORequest"
                         }
                     }
                 ],
@@ -182,23 +296,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hgetall": {
+        "/redis/bitmap/bitop": {
             "post": {
-                "description": "获取哈希表中所有的字段和值",
+                "description": "对一个或多个位图执行AND、OR、XOR、NOT运算并保存到目标键",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HGETALL操作",
+                "summary": "Redis位图BITOP操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -206,7 +327,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHGetAllRequest"
+                            "$ref": "#/definitions/types.BitmapBitOpRequest"
                         }
                     }
                 ],
@@ -228,23 +349,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hincrby": {
+        "/redis/bitmap/bitpos": {
             "post": {
-                "description": "为哈希表中的字段值加上指定增量值",
+                "description": "查找位图中第一个值为0或1的位，可按BYTE或BIT指定范围",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HINCRBY操作",
+                "summary": "Redis位图BITPOS操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -252,7 +380,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHIncrByRequest"
+                            "$ref": "#/definitions/types.BitmapBitPosRequest"
                         }
                     }
                 ],
@@ -274,23 +402,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hkeys": {
+        "/redis/bitmap/getbit": {
             "post": {
-                "description": "获取哈希表中的所有字段名",
+                "description": "获取位图指定偏移量上的位",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HKEYS操作",
+                "summary": "Redis位图GETBIT操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -298,7 +433,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHKeysRequest"
+                            "$ref": "#/definitions/types.BitmapGetBitRequest"
                         }
                     }
                 ],
@@ -320,23 +455,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hlen": {
+        "/redis/bitmap/setbit": {
             "post": {
-                "description": "获取哈希表中字段的数量",
+                "description": "设置位图指定偏移量上的位，返回原来的位值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Bitmap Operations"
                 ],
-                "summary": "Redis哈希表HLEN操作",
+                "summary": "Redis位图SETBIT操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -344,7 +486,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHLenRequest"
+                            "$ref": "#/definitions/types.BitmapSetBitRequest"
                         }
                     }
                 ],
@@ -366,23 +508,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hmget": {
+        "/redis/function/delete": {
             "post": {
-                "description": "获取哈希表中一个或多个字段的值",
+                "description": "删除函数库（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis哈希表HMGET操作",
+                "summary": "Redis函数FUNCTION DELETE操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -390,7 +539,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHMGetRequest"
+                            "$ref": "#/definitions/types.FunctionDeleteRequest"
                         }
                     }
                 ],
@@ -412,23 +561,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hset": {
+        "/redis/function/dump": {
             "post": {
-                "description": "为哈希表中的字段赋值",
+                "description": "导出所有函数库，返回base64编码的数据（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis哈希表HSET操作",
+                "summary": "Redis函数FUNCTION DUMP操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -436,7 +592,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHSetRequest"
+                            "$ref": "#/definitions/types.FunctionDumpRequest"
                         }
                     }
                 ],
@@ -458,23 +614,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/hash/hvals": {
+        "/redis/function/fcall": {
             "post": {
-                "description": "获取哈希表中所有字段的值",
+                "description": "调用函数（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis Hash Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis哈希表HVALS操作",
+                "summary": "Redis函数FCALL操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -482,7 +645,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.HashHValsRequest"
+                            "$ref": "#/definitions/types.FunctionCallRequest"
                         }
                     }
                 ],
@@ -504,23 +667,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/lindex": {
+        "/redis/function/fcall_ro": {
             "post": {
-                "description": "通过索引获取列表中的元素",
+                "description": "调用只读函数，配置了只读副本时发往副本（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis列表LINDEX操作",
+                "summary": "Redis函数FCALL_RO操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -528,7 +698,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLIndexRequest"
+                            "$ref": "#/definitions/types.FunctionCallRORequest"
                         }
                     }
                 ],
@@ -550,23 +720,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/llen": {
+        "/redis/function/list": {
             "post": {
-                "description": "获取列表长度",
+                "description": "列出函数库及其函数，可按名称模式过滤（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis列表LLEN操作",
+                "summary": "Redis函数FUNCTION LIST操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -574,7 +751,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLLenRequest"
+                            "$ref": "#/definitions/types.FunctionListRequest"
                         }
                     }
                 ],
@@ -596,23 +773,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/lpop": {
+        "/redis/function/load": {
             "post": {
-                "description": "移出并获取列表的第一个元素",
+                "description": "加载函数库，replace为true时替换同名函数库（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis列表LPOP操作",
+                "summary": "Redis函数FUNCTION LOAD操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -620,7 +804,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLPopRequest"
+                            "$ref": "#/definitions/types.FunctionLoadRequest"
                         }
                     }
                 ],
@@ -642,23 +826,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/lpush": {
+        "/redis/function/restore": {
             "post": {
-                "description": "将一个或多个值插入到列表头部",
+                "description": "从FUNCTION DUMP数据恢复函数库，支持APPEND、REPLACE、FLUSH策略（需要Redis 7.0+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Function Operations"
                 ],
-                "summary": "Redis列表LPUSH操作",
+                "summary": "Redis函数FUNCTION RESTORE操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -666,7 +857,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLPushRequest"
+                            "$ref": "#/definitions/types.FunctionRestoreRequest"
                         }
                     }
                 ],
@@ -688,23 +879,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/lrange": {
+        "/redis/geo/geoadd": {
             "post": {
-                "description": "获取列表指定范围内的元素",
+                "description": "向地理位置索引添加成员，支持NX、XX、CH标志（标志需要Redis 6.2+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Geo Operations"
                 ],
-                "summary": "Redis列表LRANGE操作",
+                "summary": "Redis地理位置GEOADD操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -712,7 +910,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLRangeRequest"
+                            "$ref": "#/definitions/types.GeoAddRequest"
                         }
                     }
                 ],
@@ -734,23 +932,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/lrem": {
+        "/redis/geo/geodist": {
             "post": {
-                "description": "根据参数count的值，移除列表中与参数value相等的元素",
+                "description": "计算两个成员之间的距离",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Geo Operations"
                 ],
-                "summary": "Redis列表LREM操作",
+                "summary": "Redis地理位置GEODIST操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -758,7 +963,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLRemRequest"
+                            "$ref": "#/definitions/types.GeoDistRequest"
                         }
                     }
                 ],
@@ -780,23 +985,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/ltrim": {
+        "/redis/geo/geohash": {
             "post": {
-                "description": "对一个列表进行修剪，让列表只保留指定区间内的元素",
+                "description": "获取成员的geohash字符串",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Geo Operations"
                 ],
-                "summary": "Redis列表LTRIM操作",
+                "summary": "Redis地理位置GEOHASH操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -804,7 +1016,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListLTrimRequest"
+                            "$ref": "#/definitions/types.GeoHashRequest"
                         }
                     }
                 ],
@@ -826,23 +1038,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/rpop": {
+        "/redis/geo/geopos": {
             "post": {
-                "description": "移出并获取列表的最后一个元素",
+                "description": "获取成员的经纬度坐标",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Geo Operations"
                 ],
-                "summary": "Redis列表RPOP操作",
+                "summary": "Redis地理位置GEOPOS操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -850,7 +1069,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListRPopRequest"
+                            "$ref": "#/definitions/types.GeoPosRequest"
                         }
                     }
                 ],
@@ -872,23 +1091,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/list/rpush": {
+        "/redis/geo/geosearch": {
             "post": {
-                "description": "将一个或多个值插入到列表尾部",
+                "description": "以成员或经纬度为中心，在圆形或矩形范围内搜索成员（需要Redis 6.2+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis List Operations"
+                    "Redis Geo Operations"
                 ],
-                "summary": "Redis列表RPUSH操作",
+                "summary": "Redis地理位置GEOSEARCH操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -896,7 +1122,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ListRPushRequest"
+                            "$ref": "#/definitions/types.GeoSearchRequest"
                         }
                     }
                 ],
@@ -918,23 +1144,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/decr": {
+        "/redis/geo/geosearchstore": {
             "post": {
-                "description": "将指定key的值减1",
+                "description": "将范围搜索的结果保存到目标键（需要Redis 6.2+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Geo Operations"
                 ],
-                "summary": "Redis字符串DECR操作",
+                "summary": "Redis地理位置GEOSEARCHSTORE操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -942,7 +1175,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringDecrRequest"
+                            "$ref": "#/definitions/types.GeoSearchStoreRequest"
                         }
                     }
                 ],
@@ -964,23 +1197,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/del": {
+        "/redis/hash/hdel": {
             "post": {
-                "description": "删除指定的key",
+                "description": "删除哈希表中一个或多个字段",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis字符串DEL操作",
+                "summary": "Redis哈希表HDEL操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -988,7 +1228,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringDelRequest"
+                            "$ref": "#/definitions/types.HashHDelRequest"
                         }
                     }
                 ],
@@ -1010,23 +1250,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/exists": {
+        "/redis/hash/hexists": {
             "post": {
-                "description": "检查指定key是否存在",
+                "description": "查看哈希表的指定字段是否存在",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis字符串EXISTS操作",
+                "summary": "Redis哈希表HEXISTS操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1034,7 +1281,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringExistsRequest"
+                            "$ref": "#/definitions/types.HashHExistsRequest"
                         }
                     }
                 ],
@@ -1056,23 +1303,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/expire": {
+        "/redis/hash/hexpire": {
             "post": {
-                "description": "为指定key设置过期时间",
+                "description": "为哈希表中的字段设置过期时间（需要Redis 7.4+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis字符串EXPIRE操作",
+                "summary": "Redis哈希表HEXPIRE操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1080,7 +1334,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringExpireRequest"
+                            "$ref": "#/definitions/types.HashHExpireRequest"
                         }
                     }
                 ],
@@ -1102,23 +1356,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/get": {
+        "/redis/hash/hget": {
             "post": {
-                "description": "根据指定的key获取Redis中存储的字符串值",
+                "description": "获取哈希表中指定字段的值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis字符串GET操作",
+                "summary": "Redis哈希表HGET操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1126,7 +1387,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringGetRequest"
+                            "$ref": "#/definitions/types.HashHGetRequest"
                         }
                     }
                 ],
@@ -1148,23 +1409,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/incr": {
+        "/redis/hash/hgetall": {
             "post": {
-                "description": "将指定key的值加1",
+                "description": "获取哈希表中所有的字段和值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis字符串INCR操作",
+                "summary": "Redis哈希表HGETALL操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1172,7 +1440,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringIncrRequest"
+                            "$ref": "#/definitions/types.HashHGetAllRequest"
                         }
                     }
                 ],
@@ -1194,23 +1462,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/string/set": {
+        "/redis/hash/hincrby": {
             "post": {
-                "description": "设置指定key的字符串值，支持TTL过期时间",
+                "description": "为哈希表中的字段值加上指定增量值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis String Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis字符串SET操作",
+                "summary": "Redis哈希表HINCRBY操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1218,7 +1493,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.StringSetRequest"
+                            "$ref": "#/definitions/types.HashHIncrByRequest"
                         }
                     }
                 ],
@@ -1240,23 +1515,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zadd": {
+        "/redis/hash/hincrbyfloat": {
             "post": {
-                "description": "将一个或多个成员元素及其分数值加入到有序集合中",
+                "description": "为哈希表中指定字段的值加上浮点数增量",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZADD操作",
+                "summary": "Redis哈希表HINCRBYFLOAT操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1264,7 +1546,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZAddRequest"
+                            "$ref": "#/definitions/types.HashHIncrByFloatRequest"
                         }
                     }
                 ],
@@ -1286,23 +1568,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zcard": {
+        "/redis/hash/hkeys": {
             "post": {
-                "description": "获取有序集合的成员数",
+                "description": "获取哈希表中的所有字段名",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZCARD操作",
+                "summary": "Redis哈希表HKEYS操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1310,7 +1599,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZCardRequest"
+                            "$ref": "#/definitions/types.HashHKeysRequest"
                         }
                     }
                 ],
@@ -1332,23 +1621,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zcount": {
+        "/redis/hash/hlen": {
             "post": {
-                "description": "计算在有序集合中指定区间分数的成员数",
+                "description": "获取哈希表中字段的数量",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZCOUNT操作",
+                "summary": "Redis哈希表HLEN操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1356,7 +1652,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZCountRequest"
+                            "$ref": "#/definitions/types.HashHLenRequest"
                         }
                     }
                 ],
@@ -1378,23 +1674,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zincrby": {
+        "/redis/hash/hmget": {
             "post": {
-                "description": "对有序集合中指定成员的分数加上增量increment",
+                "description": "获取哈希表中一个或多个字段的值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZINCRBY操作",
+                "summary": "Redis哈希表HMGET操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1402,7 +1705,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZIncrByRequest"
+                            "$ref": "#/definitions/types.HashHMGetRequest"
                         }
                     }
                 ],
@@ -1424,23 +1727,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrange": {
+        "/redis/hash/hpersist": {
             "post": {
-                "description": "通过索引区间返回有序集合成指定区间内的成员（分数从小到大）",
+                "description": "移除哈希表中字段的过期时间（需要Redis 7.4+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZRANGE操作",
+                "summary": "Redis哈希表HPERSIST操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1448,7 +1758,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRangeRequest"
+                            "$ref": "#/definitions/types.HashHPersistRequest"
                         }
                     }
                 ],
@@ -1470,23 +1780,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrangebyscore": {
+        "/redis/hash/hrandfield": {
             "post": {
-                "description": "通过分数返回有序集合指定区间内的成员（分数从小到大）",
+                "description": "从哈希表中随机返回字段，可选返回字段值（需要Redis 6.2+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZRANGEBYSCORE操作",
+                "summary": "Redis哈希表HRANDFIELD操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1494,7 +1811,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRangeByScoreRequest"
+                            "$ref": "#/definitions/types.HashHRandFieldRequest"
                         }
                     }
                 ],
@@ -1516,23 +1833,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrank": {
+        "/redis/hash/hset": {
             "post": {
-                "description": "返回有序集合中指定成员的排名，从0开始（分数从小到大）",
+                "description": "为哈希表中的字段赋值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZRANK操作",
+                "summary": "Redis哈希表HSET操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1540,7 +1864,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRankRequest"
+                            "$ref": "#/definitions/types.HashHSetRequest"
                         }
                     }
                 ],
@@ -1562,23 +1886,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrem": {
+        "/redis/hash/hsetnx": {
             "post": {
-                "description": "移除有序集合中的一个或多个成员",
+                "description": "仅当字段不存在时为哈希表中的字段赋值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZREM操作",
+                "summary": "Redis哈希表HSETNX操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1586,7 +1917,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRemRequest"
+                            "$ref": "#/definitions/types.HashHSetNXRequest"
                         }
                     }
                 ],
@@ -1608,23 +1939,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zremrangebyrank": {
+        "/redis/hash/hstrlen": {
             "post": {
-                "description": "移除有序集合中给定的排名区间的所有成员",
+                "description": "获取哈希表中指定字段值的字符串长度",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZREMRANGEBYRANK操作",
+                "summary": "Redis哈希表HSTRLEN操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1632,7 +1970,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRemRangeByRankRequest"
+                            "$ref": "#/definitions/types.HashHStrLenRequest"
                         }
                     }
                 ],
@@ -1654,23 +1992,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zremrangebyscore": {
+        "/redis/hash/httl": {
             "post": {
-                "description": "移除有序集合中给定的分数区间的所有成员",
+                "description": "获取哈希表中字段的剩余过期时间（需要Redis 7.4+）",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZREMRANGEBYSCORE操作",
+                "summary": "Redis哈希表HTTL操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1678,7 +2023,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRemRangeByScoreRequest"
+                            "$ref": "#/definitions/types.HashHTTLRequest"
                         }
                     }
                 ],
@@ -1700,23 +2045,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrevrange": {
+        "/redis/hash/hvals": {
             "post": {
-                "description": "通过索引区间返回有序集合成指定区间内的成员（分数从大到小）",
+                "description": "获取哈希表中所有字段的值",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis Hash Operations"
                 ],
-                "summary": "Redis有序集合ZREVRANGE操作",
+                "summary": "Redis哈希表HVALS操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1724,7 +2076,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRevRangeRequest"
+                            "$ref": "#/definitions/types.HashHValsRequest"
                         }
                     }
                 ],
@@ -1746,23 +2098,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrevrangebyscore": {
+        "/redis/hll/pfadd": {
             "post": {
-                "description": "通过分数返回有序集合指定区间内的成员（分数从大到小）",
+                "description": "向HyperLogLog添加元素",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis HyperLogLog Operations"
                 ],
-                "summary": "Redis有序集合ZREVRANGEBYSCORE操作",
+                "summary": "Redis HyperLogLog PFADD操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1770,7 +2129,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRevRangeByScoreRequest"
+                            "$ref": "#/definitions/types.HLLPFAddRequest"
                         }
                     }
                 ],
@@ -1792,23 +2151,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zrevrank": {
+        "/redis/hll/pfcount": {
             "post": {
-                "description": "返回有序集合中指定成员的排名，从0开始（分数从大到小）",
+                "description": "返回一个或多个HyperLogLog并集的近似基数",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis HyperLogLog Operations"
                 ],
-                "summary": "Redis有序集合ZREVRANK操作",
+                "summary": "Redis HyperLogLog PFCOUNT操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1816,7 +2182,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZRevRankRequest"
+                            "$ref": "#/definitions/types.HLLPFCountRequest"
                         }
                     }
                 ],
@@ -1838,23 +2204,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
         },
-        "/redis/zset/zscore": {
+        "/redis/hll/pfmerge": {
             "post": {
-                "description": "返回有序集合中指定成员的分数值",
+                "description": "将多个HyperLogLog合并到目标键",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/problem+json"
                 ],
                 "tags": [
-                    "Redis ZSet Operations"
+                    "Redis HyperLogLog Operations"
                 ],
-                "summary": "Redis有序集合ZSCORE操作",
+                "summary": "Redis HyperLogLog PFMERGE操作",
                 "parameters": [
                     {
                         "description": "请求参数",
@@ -1862,7 +2235,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.ZSetZScoreRequest"
+                            "$ref": "#/definitions/types.HLLPFMergeRequest"
                         }
                     }
                 ],
@@ -1884,76 +2257,5982 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.BaseResponse"
                         }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "response.BaseResponse": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "data": {},
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
         },
-        "types.HashHDelRequest": {
-            "type": "object",
-            "properties": {
-                "addr": {
-                    "type": "string"
-                },
-                "db": {
-                    "type": "integer"
-                },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+        "/redis/list/lindex": {
+            "post": {
+                "description": "通过索引获取列表中的元素",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LINDEX操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLIndexRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/llen": {
+            "post": {
+                "description": "获取列表长度",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LLEN操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLLenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/lpop": {
+            "post": {
+                "description": "移出并获取列表的第一个元素",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LPOP操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLPopRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/lpush": {
+            "post": {
+                "description": "将一个或多个值插入到列表头部",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LPUSH操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/lrange": {
+            "post": {
+                "description": "获取列表指定范围内的元素",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LRANGE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLRangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/lrem": {
+            "post": {
+                "description": "根据参数count的值，移除列表中与参数value相等的元素",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LREM操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLRemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/ltrim": {
+            "post": {
+                "description": "对一个列表进行修剪，让列表只保留指定区间内的元素",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表LTRIM操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListLTrimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/rpop": {
+            "post": {
+                "description": "移出并获取列表的最后一个元素",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表RPOP操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListRPopRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/list/rpush": {
+            "post": {
+                "description": "将一个或多个值插入到列表尾部",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis List Operations"
+                ],
+                "summary": "Redis列表RPUSH操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ListRPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/pipeline": {
+            "post": {
+                "description": "通过Pipeline批量执行数据命令，结果顺序与commands一致，单个命令的Redis错误在对应结果的error中返回",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Pipeline Operations"
+                ],
+                "summary": "Redis Pipeline批量执行",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.PipelineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/eval": {
+            "post": {
+                "description": "执行临时Lua脚本，read_only时使用EVAL_RO（需要配置SCRIPT_ENABLE_EVAL开启）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "Redis脚本EVAL操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ScriptEvalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/evalsha": {
+            "post": {
+                "description": "按SHA1执行已缓存的Lua脚本，read_only时使用EVALSHA_RO（需要配置SCRIPT_ENABLE_EVAL开启）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "Redis脚本EVALSHA操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ScriptEvalShaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/exists": {
+            "post": {
+                "description": "检查脚本是否存在于服务器脚本缓存",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "Redis脚本SCRIPT EXISTS操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ScriptExistsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/flush": {
+            "post": {
+                "description": "清空服务器脚本缓存（需要配置SCRIPT_ENABLE_EVAL开启）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "Redis脚本SCRIPT FLUSH操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ScriptFlushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/list": {
+            "get": {
+                "description": "列出服务端注册的命名脚本及其启用状态",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "命名脚本列表",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/load": {
+            "post": {
+                "description": "将Lua脚本加载到服务器脚本缓存（需要配置SCRIPT_ENABLE_EVAL开启）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "Redis脚本SCRIPT LOAD操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ScriptLoadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/script/run": {
+            "post": {
+                "description": "按名称执行服务端注册的Lua脚本，自动处理SHA缓存与NOSCRIPT重试",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis Script Operations"
+                ],
+                "summary": "Redis脚本命名脚本执行操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ScriptRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/decr": {
+            "post": {
+                "description": "将指定key的值减1",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串DECR操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringDecrRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/del": {
+            "post": {
+                "description": "删除指定的key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串DEL操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringDelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/exists": {
+            "post": {
+                "description": "检查指定key是否存在",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串EXISTS操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringExistsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/expire": {
+            "post": {
+                "description": "为指定key设置过期时间",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串EXPIRE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringExpireRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/get": {
+            "post": {
+                "description": "根据指定的key获取Redis中存储的字符串值",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串GET操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/incr": {
+            "post": {
+                "description": "将指定key的值加1",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串INCR操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringIncrRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/mget": {
+            "post": {
+                "description": "批量获取多个key的值，结果顺序与keys一致；集群目标按哈希槽拆分后合并",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串MGET操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringMGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/string/set": {
+            "post": {
+                "description": "设置指定key的字符串值，支持TTL过期时间",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis String Operations"
+                ],
+                "summary": "Redis字符串SET操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StringSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/bzpopmin": {
+            "post": {
+                "description": "阻塞式移除并返回第一个非空有序集合中分数最低的成员。timeout必须大于0，且短于请求超时（timeout_ms或服务端request_timeout_ms），否则返回400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合BZPOPMIN操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetBZPopMinRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zadd": {
+            "post": {
+                "description": "将一个或多个成员元素及其分数值加入到有序集合中",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZADD操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZAddRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zcard": {
+            "post": {
+                "description": "获取有序集合的成员数",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZCARD操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZCardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zcount": {
+            "post": {
+                "description": "计算在有序集合中指定区间分数的成员数",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZCOUNT操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZCountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zdiff": {
+            "post": {
+                "description": "返回第一个有序集合与其余有序集合的差集",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZDIFF操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZDiffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zdiffstore": {
+            "post": {
+                "description": "计算第一个有序集合与其余有序集合的差集并存储到目标key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZDIFFSTORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZDiffStoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zincrby": {
+            "post": {
+                "description": "对有序集合中指定成员的分数加上增量increment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZINCRBY操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZIncrByRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zinter": {
+            "post": {
+                "description": "返回多个有序集合的交集，支持WEIGHTS和AGGREGATE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZINTER操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZInterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zinterstore": {
+            "post": {
+                "description": "计算多个有序集合的交集并存储到目标key，支持WEIGHTS和AGGREGATE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZINTERSTORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZInterStoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zlexcount": {
+            "post": {
+                "description": "计算有序集合中指定字典区间内成员数量",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZLEXCOUNT操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZLexCountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zmscore": {
+            "post": {
+                "description": "返回有序集合中多个成员的分数值，不存在的成员返回null",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZMSCORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZMScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zpopmax": {
+            "post": {
+                "description": "移除并返回有序集合中分数最高的成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZPOPMAX操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZPopMaxRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zpopmin": {
+            "post": {
+                "description": "移除并返回有序集合中分数最低的成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZPOPMIN操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZPopMinRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrandmember": {
+            "post": {
+                "description": "从有序集合中随机返回成员，可选返回分数",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZRANDMEMBER操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRandMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrange": {
+            "post": {
+                "description": "通过索引区间返回有序集合成指定区间内的成员（分数从小到大）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZRANGE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrangebylex": {
+            "post": {
+                "description": "通过字典区间返回有序集合的成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZRANGEBYLEX操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRangeByLexRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrangebyscore": {
+            "post": {
+                "description": "通过分数返回有序集合指定区间内的成员（分数从小到大）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZRANGEBYSCORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRangeByScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrank": {
+            "post": {
+                "description": "返回有序集合中指定成员的排名，从0开始（分数从小到大）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZRANK操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRankRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrem": {
+            "post": {
+                "description": "移除有序集合中的一个或多个成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREM操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zremrangebylex": {
+            "post": {
+                "description": "移除有序集合中给定的字典区间的所有成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREMRANGEBYLEX操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRemRangeByLexRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zremrangebyrank": {
+            "post": {
+                "description": "移除有序集合中给定的排名区间的所有成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREMRANGEBYRANK操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRemRangeByRankRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zremrangebyscore": {
+            "post": {
+                "description": "移除有序集合中给定的分数区间的所有成员",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREMRANGEBYSCORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRemRangeByScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrevrange": {
+            "post": {
+                "description": "通过索引区间返回有序集合成指定区间内的成员（分数从大到小）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREVRANGE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRevRangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrevrangebyscore": {
+            "post": {
+                "description": "通过分数返回有序集合指定区间内的成员（分数从大到小）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREVRANGEBYSCORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRevRangeByScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zrevrank": {
+            "post": {
+                "description": "返回有序集合中指定成员的排名，从0开始（分数从大到小）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZREVRANK操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZRevRankRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zscore": {
+            "post": {
+                "description": "返回有序集合中指定成员的分数值",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZSCORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zunion": {
+            "post": {
+                "description": "返回多个有序集合的并集，支持WEIGHTS和AGGREGATE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZUNION操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZUnionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/redis/zset/zunionstore": {
+            "post": {
+                "description": "计算多个有序集合的并集并存储到目标key，支持WEIGHTS和AGGREGATE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/problem+json"
+                ],
+                "tags": [
+                    "Redis ZSet Operations"
+                ],
+                "summary": "Redis有序集合ZUNIONSTORE操作",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ZSetZUnionStoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器内部错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.BaseResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "错误详情（Accept为application/problem+json时）",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "errors.Catalog": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/errors.ErrorInfo"
+                    }
+                },
+                "locales": {
+                    "description": "注册时使用的中文在前",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "zh",
+                        "en"
+                    ]
+                },
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/errors.ModuleInfo"
+                    }
+                }
+            }
+        },
+        "errors.ErrorInfo": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "http_status": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "messages": {
+                    "description": "Messages 各语言的消息模板，包括注册时的中文消息",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "module": {
+                    "type": "string"
+                },
+                "retryable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "errors.ModuleInfo": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "String操作错误"
+                },
+                "max": {
+                    "type": "integer",
+                    "example": 2199
+                },
+                "min": {
+                    "type": "integer",
+                    "example": 2100
+                },
+                "module": {
+                    "type": "string",
+                    "example": "string"
+                }
+            }
+        },
+        "response.BaseResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "details": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "retryable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "response.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Extension members",
+                    "type": "integer",
+                    "example": 2101
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "instance": {
+                    "description": "request ID",
+                    "type": "string",
+                    "example": "20250728100000-a1b2c3d4"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "string"
+                },
+                "retryable": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 409
+                },
+                "title": {
+                    "type": "string",
+                    "example": "类型不匹配"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:go-redis-proxy:error:2101"
+                }
+            }
+        },
+        "types.BitFieldOperation": {
+            "type": "object",
+            "properties": {
+                "increment": {
+                    "description": "INCRBY的增量",
+                    "type": "integer"
+                },
+                "offset": {
+                    "description": "位偏移，支持#N形式表示按类型宽度的倍数",
+                    "type": "string"
+                },
+                "op": {
+                    "description": "子操作：GET、SET、INCRBY、OVERFLOW",
+                    "type": "string"
+                },
+                "overflow": {
+                    "description": "OVERFLOW模式：WRAP、SAT、FAIL",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "整数类型，如u8、i16（OVERFLOW不需要）",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "SET写入的值",
+                    "type": "integer"
+                }
+            }
+        },
+        "types.BitmapBitCountRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "end": {
+                    "description": "结束位置，需与start同时指定",
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "start": {
+                    "description": "起始位置，需与end同时指定",
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "unit": {
+                    "description": "范围单位：BYTE（默认）或BIT（需要Redis 7.0+）",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.BitmapBitFieldRORequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "operations": {
+                    "description": "只允许GET子操作",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.BitFieldOperation"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.BitmapBitFieldRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "operations": {
+                    "description": "按顺序执行的子操作",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.BitFieldOperation"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.BitmapBitOpRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "destination": {
+                    "description": "结果键",
+                    "type": "string"
+                },
+                "keys": {
+                    "description": "源键列表，NOT只能指定一个键",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "operation": {
+                    "description": "位运算：AND、OR、XOR、NOT",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.BitmapBitPosRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "bit": {
+                    "description": "要查找的位值，只能为0或1",
+                    "type": "integer"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "end": {
+                    "description": "结束位置，需同时指定start",
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "start": {
+                    "description": "起始位置",
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "unit": {
+                    "description": "范围单位：BYTE（默认）或BIT（需要Redis 7.0+）",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.BitmapGetBitRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "offset": {
+                    "description": "位偏移",
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.BitmapSetBitRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "offset": {
+                    "description": "位偏移",
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "位值，只能为0或1",
+                    "type": "integer"
+                }
+            }
+        },
+        "types.FunctionCallRORequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "args": {
+                    "description": "其他参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "function": {
+                    "description": "函数名，需声明no-writes标志",
+                    "type": "string"
+                },
+                "keys": {
+                    "description": "键参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "use_primary": {
+                    "description": "即使配置了副本也发往主节点",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.FunctionCallRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "args": {
+                    "description": "其他参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "function": {
+                    "description": "函数名",
+                    "type": "string"
+                },
+                "keys": {
+                    "description": "键参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.FunctionDeleteRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "library_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.FunctionDumpRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.FunctionListRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "library_name": {
+                    "description": "函数库名匹配模式",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "with_code": {
+                    "description": "返回函数库源码",
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.FunctionLoadRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "函数库源码，首行需为#!lua name=\u003clibrary\u003e",
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "replace": {
+                    "description": "替换同名函数库",
+                    "type": "boolean"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.FunctionRestoreRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "FUNCTION DUMP返回的base64编码数据",
+                    "type": "string"
+                },
+                "policy": {
+                    "description": "恢复策略：APPEND（默认）、REPLACE、FLUSH",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.GeoAddRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "ch": {
+                    "description": "返回值包含被更新位置的成员数",
+                    "type": "boolean"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.GeoLocation"
+                    }
+                },
+                "nx": {
+                    "description": "只添加新成员，不更新已有成员",
+                    "type": "boolean"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "xx": {
+                    "description": "只更新已有成员，不添加新成员",
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.GeoDistRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "member1": {
+                    "type": "string"
+                },
+                "member2": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "unit": {
+                    "description": "距离单位：m（默认）、km、ft、mi",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.GeoHashRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.GeoLocation": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "description": "纬度，范围-85.05112878到85.05112878",
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "经度，范围-180到180",
+                    "type": "number"
+                },
+                "member": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.GeoPosRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.GeoSearchRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "any": {
+                    "description": "找到count个成员后立即返回，需指定count",
+                    "type": "boolean"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "最多返回的成员数，0表示不限制",
+                    "type": "integer"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "height": {
+                    "description": "矩形范围高度（BYBOX）",
+                    "type": "number"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "以经纬度为中心（FROMLONLAT）",
+                    "type": "number"
+                },
+                "member": {
+                    "description": "以已有成员为中心（FROMMEMBER）",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "radius": {
+                    "description": "圆形范围半径（BYRADIUS）",
+                    "type": "number"
+                },
+                "sort": {
+                    "description": "排序：ASC或DESC，为空时不排序",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "unit": {
+                    "description": "距离单位：m（默认）、km、ft、mi",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "width": {
+                    "description": "矩形范围宽度（BYBOX）",
+                    "type": "number"
+                },
+                "with_coord": {
+                    "description": "返回成员的经纬度",
+                    "type": "boolean"
+                },
+                "with_dist": {
+                    "description": "返回成员到中心点的距离",
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.GeoSearchStoreRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "any": {
+                    "description": "找到count个成员后立即返回，需指定count",
+                    "type": "boolean"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "最多返回的成员数，0表示不限制",
+                    "type": "integer"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "destination": {
+                    "description": "结果键",
+                    "type": "string"
+                },
+                "height": {
+                    "description": "矩形范围高度（BYBOX）",
+                    "type": "number"
+                },
+                "key": {
+                    "description": "源键",
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "以经纬度为中心（FROMLONLAT）",
+                    "type": "number"
+                },
+                "member": {
+                    "description": "以已有成员为中心（FROMMEMBER）",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "radius": {
+                    "description": "圆形范围半径（BYRADIUS）",
+                    "type": "number"
+                },
+                "sort": {
+                    "description": "排序：ASC或DESC，为空时不排序",
+                    "type": "string"
+                },
+                "store_dist": {
+                    "description": "以距离而非geohash作为结果的分数",
+                    "type": "boolean"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "unit": {
+                    "description": "距离单位：m（默认）、km、ft、mi",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "width": {
+                    "description": "矩形范围宽度（BYBOX）",
+                    "type": "number"
+                }
+            }
+        },
+        "types.HLLPFAddRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "elements": {
+                    "description": "要添加的元素，为空时仅创建键",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HLLPFCountRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "keys": {
+                    "description": "多个键时返回并集的近似基数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HLLPFMergeRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "destination": {
+                    "description": "结果键",
+                    "type": "string"
+                },
+                "keys": {
+                    "description": "源键列表",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHDelRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHExistsRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "field": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHExpireRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "condition": {
+                    "description": "NX, XX, GT, LT",
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "ttl": {
+                    "description": "过期时间，单位秒",
+                    "type": "integer"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHGetAllRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "json_path": {
+                    "description": "encoding为json时只返回每个文档中的子字段",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHGetRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "field": {
+                    "type": "string"
+                },
+                "json_path": {
+                    "description": "encoding为json时只返回文档中的子字段，如$.user.tags[0]",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHIncrByFloatRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "field": {
+                    "type": "string"
+                },
+                "increment": {
+                    "type": "number"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHIncrByRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "field": {
+                    "type": "string"
+                },
+                "increment": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHKeysRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHLenRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHMGetRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "json_path": {
+                    "description": "encoding为json时只返回每个文档中的子字段",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHPersistRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHRandFieldRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "返回数量，0表示单个字段，负数表示允许重复",
+                    "type": "integer"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "with_values": {
+                    "description": "是否返回字段值",
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.HashHSetNXRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "field": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHSetRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "fields": {
+                    "description": "field -\u003e value 映射，encoding为json时value可以是任意JSON",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHStrLenRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "field": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHTTLRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.HashHValsRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListLIndexRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "index": {
+                    "description": "索引位置",
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListLLenRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListLPopRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListLPushRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "values": {
+                    "description": "要推入的值数组",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "types.ListLRangeRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "start": {
+                    "description": "开始索引",
+                    "type": "integer"
+                },
+                "stop": {
+                    "description": "结束索引",
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListLRemRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "删除的数量，0表示删除所有",
+                    "type": "integer"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "要删除的值",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListLTrimRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "start": {
+                    "description": "开始索引",
+                    "type": "integer"
+                },
+                "stop": {
+                    "description": "结束索引",
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListRPopRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ListRPushRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "values": {
+                    "description": "要推入的值数组",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "types.PipelineRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "commands": {
+                    "description": "命令列表，每个命令为命令名加参数，如[\"SET\",\"k\",\"v\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ScriptEvalRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "args": {
+                    "description": "ARGV参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "keys": {
+                    "description": "KEYS参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "read_only": {
+                    "description": "使用EVAL_RO执行（需要Redis 7.0+）",
+                    "type": "boolean"
+                },
+                "script": {
+                    "description": "Lua脚本内容",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ScriptEvalShaRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "args": {
+                    "description": "ARGV参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "keys": {
+                    "description": "KEYS参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "read_only": {
+                    "description": "使用EVALSHA_RO执行（需要Redis 7.0+）",
+                    "type": "boolean"
+                },
+                "sha": {
+                    "description": "脚本的SHA1摘要",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ScriptExistsRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "shas": {
+                    "description": "脚本的SHA1摘要列表",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ScriptFlushRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "description": "清空模式：ASYNC或SYNC（需要Redis 6.2+），为空时使用服务器默认值",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ScriptLoadRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "script": {
+                    "description": "Lua脚本内容",
+                    "type": "string"
                 },
-                "key": {
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
                     "type": "string"
                 },
-                "password": {
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
                 }
             }
         },
-        "types.HashHExistsRequest": {
+        "types.ScriptRunRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "args": {
+                    "description": "ARGV参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "field": {
-                    "type": "string"
+                "keys": {
+                    "description": "KEYS参数",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "key": {
+                "name": {
+                    "description": "注册的脚本名",
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "read_only": {
+                    "description": "使用EVALSHA_RO执行（需要Redis 7.0+）",
+                    "type": "boolean"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHGetAllRequest": {
+        "types.StringDecrRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
@@ -1962,42 +8241,73 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHGetRequest": {
+        "types.StringDelRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "field": {
-                    "type": "string"
-                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHIncrByRequest": {
+        "types.StringExistsRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
-                "db": {
-                    "type": "integer"
-                },
-                "field": {
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
                     "type": "string"
                 },
-                "increment": {
+                "db": {
                     "type": "integer"
                 },
                 "key": {
@@ -2005,15 +8315,35 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHKeysRequest": {
+        "types.StringExpireRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
@@ -2022,151 +8352,437 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "ttl": {
+                    "description": "过期时间，单位秒",
+                    "type": "integer"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHLenRequest": {
+        "types.StringGetRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "json_path": {
+                    "description": "encoding为json时只返回文档中的子字段，如$.user.tags[0]",
+                    "type": "string"
+                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHMGetRequest": {
+        "types.StringIncrRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.HashHSetRequest": {
+        "types.StringMGetRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "fields": {
-                    "description": "field -\u003e value 映射",
-                    "type": "object",
-                    "additionalProperties": {
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "json_path": {
+                    "description": "encoding为json时只返回每个文档中的子字段",
+                    "type": "string"
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
                         "type": "string"
                     }
                 },
-                "key": {
+                "password": {
                     "type": "string"
                 },
-                "password": {
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
                 }
             }
         },
-        "types.HashHValsRequest": {
+        "types.StringSetRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
-                }
-            }
-        },
-        "types.ListLIndexRequest": {
-            "type": "object",
-            "properties": {
-                "addr": {
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
                     "type": "string"
                 },
-                "db": {
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
                 },
-                "index": {
-                    "description": "索引位置",
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "ttl": {
+                    "description": "过期时间，单位秒，0表示不过期",
                     "type": "integer"
                 },
-                "key": {
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
                 },
-                "password": {
+                "value": {
+                    "description": "encoding为json时可以是任意JSON",
                     "type": "string"
                 }
             }
         },
-        "types.ListLLenRequest": {
+        "types.ZSetBZPopMinRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "key": {
-                    "type": "string"
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout": {
+                    "description": "阻塞超时时间，单位秒，必须大于0且短于请求超时",
+                    "type": "number"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.ListLPopRequest": {
+        "types.ZSetMember": {
+            "type": "object",
+            "properties": {
+                "member": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "types.ZSetZAddRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "ch": {
+                    "description": "返回值改为被修改（新增+更新）的成员数量",
+                    "type": "boolean"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "gt": {
+                    "description": "仅当新分数大于当前分数时更新",
+                    "type": "boolean"
+                },
+                "incr": {
+                    "description": "以ZINCRBY方式对唯一成员的分数做增量",
+                    "type": "boolean"
+                },
                 "key": {
                     "type": "string"
                 },
+                "lt": {
+                    "description": "仅当新分数小于当前分数时更新",
+                    "type": "boolean"
+                },
+                "members": {
+                    "description": "member -\u003e score 映射，按成员名排序后发送",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "nx": {
+                    "description": "只添加新成员，不更新已存在成员",
+                    "type": "boolean"
+                },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "scored_members": {
+                    "description": "有序的成员列表，按给定顺序发送",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ZSetMember"
+                    }
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "xx": {
+                    "description": "只更新已存在成员，不添加新成员",
+                    "type": "boolean"
                 }
             }
         },
-        "types.ListLPushRequest": {
+        "types.ZSetZCardRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
@@ -2176,427 +8792,1042 @@ const docTemplate = `{
                 "password": {
                     "type": "string"
                 },
-                "values": {
-                    "description": "要推入的值数组",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.ListLRangeRequest": {
+        "types.ZSetZCountRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
                 "key": {
                     "type": "string"
                 },
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
                 "password": {
                     "type": "string"
                 },
-                "start": {
-                    "description": "开始索引",
-                    "type": "integer"
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
                 },
-                "stop": {
-                    "description": "结束索引",
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.ListLRemRequest": {
+        "types.ZSetZDiffRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
-                "count": {
-                    "description": "删除的数量，0表示删除所有",
-                    "type": "integer"
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
                 },
                 "db": {
                     "type": "integer"
                 },
-                "key": {
-                    "type": "string"
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "keys": {
+                    "description": "第一个key与其余key的差集",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "password": {
                     "type": "string"
                 },
-                "value": {
-                    "description": "要删除的值",
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
+                },
+                "with_scores": {
+                    "type": "boolean"
                 }
             }
         },
-        "types.ListLTrimRequest": {
+        "types.ZSetZDiffStoreRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "key": {
+                "destination": {
                     "type": "string"
                 },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "password": {
                     "type": "string"
                 },
-                "start": {
-                    "description": "开始索引",
-                    "type": "integer"
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
                 },
-                "stop": {
-                    "description": "结束索引",
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.ListRPopRequest": {
+        "types.ZSetZIncrByRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "increment": {
+                    "type": "number"
+                },
                 "key": {
                     "type": "string"
                 },
+                "member": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.ListRPushRequest": {
+        "types.ZSetZInterRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "aggregate": {
+                    "description": "SUM, MIN, MAX",
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "key": {
-                    "type": "string"
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "password": {
                     "type": "string"
                 },
-                "values": {
-                    "description": "要推入的值数组",
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "weights": {
+                    "description": "每个key的权重，数量需与keys一致",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "number"
                     }
+                },
+                "with_scores": {
+                    "type": "boolean"
                 }
             }
         },
-        "types.StringDecrRequest": {
+        "types.ZSetZInterStoreRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "aggregate": {
+                    "description": "SUM, MIN, MAX",
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
-                "key": {
+                "destination": {
                     "type": "string"
                 },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "password": {
                     "type": "string"
-                }
-            }
-        },
-        "types.StringDelRequest": {
-            "type": "object",
-            "properties": {
-                "addr": {
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
                     "type": "string"
                 },
-                "db": {
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
                 },
-                "key": {
-                    "type": "string"
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
                 },
-                "password": {
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
+                },
+                "weights": {
+                    "description": "每个key的权重，数量需与keys一致",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
                 }
             }
         },
-        "types.StringExistsRequest": {
+        "types.ZSetZLexCountRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
+                "max": {
+                    "description": "支持 -, +, [value, (value 等格式",
+                    "type": "string"
+                },
+                "min": {
+                    "description": "支持 -, +, [value, (value 等格式",
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.StringExpireRequest": {
+        "types.ZSetZMScoreRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "password": {
                     "type": "string"
                 },
-                "ttl": {
-                    "description": "过期时间，单位秒",
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.StringGetRequest": {
+        "types.ZSetZPopMaxRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "弹出数量，0表示弹出一个",
+                    "type": "integer"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.StringIncrRequest": {
+        "types.ZSetZPopMinRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "弹出数量，0表示弹出一个",
+                    "type": "integer"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.StringSetRequest": {
+        "types.ZSetZRandMemberRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "返回数量，0表示单个成员，负数表示允许重复",
+                    "type": "integer"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
                 },
-                "ttl": {
-                    "description": "过期时间，单位秒，0表示不过期",
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
                 },
-                "value": {
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
+                },
+                "with_scores": {
+                    "description": "是否返回分数",
+                    "type": "boolean"
                 }
             }
         },
-        "types.ZSetZAddRequest": {
+        "types.ZSetZRangeByLexRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
-                "members": {
-                    "description": "member -\u003e score 映射",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "number",
-                        "format": "float64"
-                    }
+                "max": {
+                    "description": "支持 -, +, [value, (value 等格式",
+                    "type": "string"
+                },
+                "min": {
+                    "description": "支持 -, +, [value, (value 等格式",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
                 },
                 "password": {
                     "type": "string"
-                }
-            }
-        },
-        "types.ZSetZCardRequest": {
-            "type": "object",
-            "properties": {
-                "addr": {
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
                     "type": "string"
                 },
-                "db": {
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
                 },
-                "key": {
-                    "type": "string"
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
                 },
-                "password": {
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
                 }
             }
         },
-        "types.ZSetZCountRequest": {
+        "types.ZSetZRangeByScoreRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "max": {
-                    "type": "number"
+                    "description": "支持 -inf, +inf, (value 等格式",
+                    "type": "string"
                 },
                 "min": {
-                    "type": "number"
+                    "description": "支持 -inf, +inf, (value 等格式",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
                 },
                 "password": {
                     "type": "string"
-                }
-            }
-        },
-        "types.ZSetZIncrByRequest": {
-            "type": "object",
-            "properties": {
-                "addr": {
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
                     "type": "string"
                 },
-                "db": {
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
                 },
-                "increment": {
-                    "type": "number"
-                },
-                "key": {
-                    "type": "string"
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
                 },
-                "member": {
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
                     "type": "string"
                 },
-                "password": {
-                    "type": "string"
+                "with_scores": {
+                    "type": "boolean"
                 }
             }
         },
-        "types.ZSetZRangeByScoreRequest": {
+        "types.ZSetZRangeRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "by_lex": {
+                    "description": "按字典序区间查询",
+                    "type": "boolean"
+                },
+                "by_score": {
+                    "description": "按分数区间查询",
+                    "type": "boolean"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "count": {
+                    "description": "LIMIT数量，仅by_score/by_lex时有效",
                     "type": "integer"
                 },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "max": {
-                    "description": "支持 -inf, +inf, (value 等格式",
+                    "description": "同min",
                     "type": "string"
                 },
                 "min": {
-                    "description": "支持 -inf, +inf, (value 等格式",
+                    "description": "by_score时支持 -inf, +inf, (value；by_lex时支持 -, +, [value, (value",
                     "type": "string"
                 },
                 "offset": {
+                    "description": "LIMIT偏移量，仅by_score/by_lex时有效",
                     "type": "integer"
                 },
                 "password": {
                     "type": "string"
                 },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "rev": {
+                    "description": "逆序返回",
+                    "type": "boolean"
+                },
+                "start": {
+                    "type": "integer"
+                },
+                "stop": {
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
                 "with_scores": {
+                    "description": "是否返回分数",
                     "type": "boolean"
                 }
             }
         },
-        "types.ZSetZRangeRequest": {
+        "types.ZSetZRankRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
+                "member": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
                 },
-                "start": {
-                    "type": "integer"
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
                 },
-                "stop": {
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
                     "type": "integer"
                 },
-                "with_scores": {
-                    "description": "是否返回分数",
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
                     "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
-        "types.ZSetZRankRequest": {
+        "types.ZSetZRemRangeByLexRequest": {
             "type": "object",
             "properties": {
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
-                "member": {
+                "max": {
+                    "description": "支持 -, +, [value, (value 等格式",
+                    "type": "string"
+                },
+                "min": {
+                    "description": "支持 -, +, [value, (value 等格式",
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
@@ -2606,6 +9837,10 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
@@ -2620,6 +9855,22 @@ const docTemplate = `{
                 },
                 "stop": {
                     "type": "integer"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
@@ -2629,6 +9880,10 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
@@ -2645,6 +9900,22 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
@@ -2654,9 +9925,23 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
@@ -2668,6 +9953,32 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
@@ -2677,12 +9988,26 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "count": {
                     "type": "integer"
                 },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
@@ -2700,6 +10025,32 @@ const docTemplate = `{
                 "password": {
                     "type": "string"
                 },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
                 "with_scores": {
                     "type": "boolean"
                 }
@@ -2711,21 +10062,61 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
                 "password": {
                     "type": "string"
                 },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "start": {
                     "type": "integer"
                 },
                 "stop": {
                     "type": "integer"
                 },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
                 "with_scores": {
                     "description": "是否返回分数",
                     "type": "boolean"
@@ -2738,9 +10129,23 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
@@ -2749,6 +10154,32 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
                 }
             }
         },
@@ -2758,9 +10189,23 @@ const docTemplate = `{
                 "addr": {
                     "type": "string"
                 },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
                 "db": {
                     "type": "integer"
                 },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
                 "key": {
                     "type": "string"
                 },
@@ -2769,6 +10214,160 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ZSetZUnionRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "aggregate": {
+                    "description": "SUM, MIN, MAX",
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "description": "请求中值、成员和字段的编码，默认utf8",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "response_encoding": {
+                    "description": "响应中值、成员和字段的编码，为空时与encoding相同",
+                    "type": "string",
+                    "enum": [
+                        "utf8",
+                        "base64",
+                        "hex",
+                        "json"
+                    ]
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "weights": {
+                    "description": "每个key的权重，数量需与keys一致",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "with_scores": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.ZSetZUnionStoreRequest": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "aggregate": {
+                    "description": "SUM, MIN, MAX",
+                    "type": "string"
+                },
+                "consistency": {
+                    "description": "strong时读操作也发往主节点，默认允许读副本",
+                    "type": "string"
+                },
+                "db": {
+                    "type": "integer"
+                },
+                "destination": {
+                    "type": "string"
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "命名目标，设置后忽略addr、username、password、db和tls",
+                    "type": "string"
+                },
+                "timeout_ms": {
+                    "description": "请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值",
+                    "type": "integer"
+                },
+                "tls": {
+                    "description": "使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置",
+                    "type": "boolean"
+                },
+                "username": {
+                    "description": "Redis 6 ACL用户名，为空时使用default用户",
+                    "type": "string"
+                },
+                "weights": {
+                    "description": "每个key的权重，数量需与keys一致",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
                 }
             }
         }
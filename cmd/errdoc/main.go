@@ -0,0 +1,60 @@
+// Command errdoc generates the error code reference from the error registry.
+//
+// Usage:
+//
+//	go run ./cmd/errdoc [-format markdown|json] [-catalog-dir dir] [-o file]
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+)
+
+//go:generate go run . -o ../../docs/errors.md
+
+func main() {
+	format := flag.String("format", "markdown", "output format: markdown or json")
+	catalogDir := flag.String("catalog-dir", "", "directory of additional message catalogs, same as i18n catalog_dir")
+	output := flag.String("o", "", "output file, defaults to stdout")
+	flag.Parse()
+
+	if err := errors.ValidateRegistry(); err != nil {
+		log.Fatalf("Error code validation failed: %v", err)
+	}
+	if err := errors.LoadCatalogs(*catalogDir); err != nil {
+		log.Fatalf("Failed to load message catalogs: %v", err)
+	}
+	catalog, err := errors.GetCatalog()
+	if err != nil {
+		log.Fatalf("Failed to build error catalog: %v", err)
+	}
+
+	var buf bytes.Buffer
+	switch *format {
+	case "markdown":
+		writeMarkdown(&buf, catalog)
+	case "json":
+		encoder := json.NewEncoder(&buf)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(catalog); err != nil {
+			log.Fatalf("Failed to encode error catalog: %v", err)
+		}
+	default:
+		log.Fatalf("Unknown format %q, must be markdown or json", *format)
+	}
+
+	if *output == "" {
+		fmt.Print(buf.String())
+		return
+	}
+	if err := os.WriteFile(*output, buf.Bytes(), 0o644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *output, err)
+	}
+}
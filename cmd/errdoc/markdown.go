@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+)
+
+// writeMarkdown renders the catalog as one table per module, with a message column per locale
+func writeMarkdown(w io.Writer, catalog errors.Catalog) {
+	fmt.Fprintln(w, "# 错误码")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "<!-- 由 go run ./cmd/errdoc -o docs/errors.md 生成，请勿手动修改 -->")
+	fmt.Fprintln(w)
+	io.WriteString(w, "错误码按模块划分号段，运行中的服务可通过 `GET /api/v1/meta/errors` 获取同样的内容。消息中的 `%s`、`%d` 等占位符在返回时替换为具体的值。\n")
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "| 模块 | 说明 | 号段 |")
+	fmt.Fprintln(w, "|------|------|------|")
+	for _, module := range catalog.Modules {
+		fmt.Fprintf(w, "| %s | %s | %d-%d |\n", module.Module, module.Description, module.Min, module.Max)
+	}
+
+	header := "| 错误码 | HTTP状态码 | 可重试 |"
+	separator := "|--------|------------|--------|"
+	for _, locale := range catalog.Locales {
+		header += " " + locale + " |"
+		separator += "----|"
+	}
+
+	written := make(map[int]bool, len(catalog.Errors))
+	for _, module := range catalog.Modules {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "## %s（%d-%d）\n", module.Description, module.Min, module.Max)
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, header)
+		fmt.Fprintln(w, separator)
+		for _, info := range catalog.Errors {
+			if info.Code < module.Min || info.Code > module.Max {
+				continue
+			}
+			writeRow(w, info, catalog.Locales)
+			written[info.Code] = true
+		}
+	}
+
+	// Codes registered at runtime outside the documented ranges
+	var others []errors.ErrorInfo
+	for _, info := range catalog.Errors {
+		if !written[info.Code] {
+			others = append(others, info)
+		}
+	}
+	if len(others) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "## 其他")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, header)
+		fmt.Fprintln(w, separator)
+		for _, info := range others {
+			writeRow(w, info, catalog.Locales)
+		}
+	}
+}
+
+// writeRow renders one error code as a table row
+func writeRow(w io.Writer, info errors.ErrorInfo, locales []string) {
+	retryable := "否"
+	if info.Retryable {
+		retryable = "是"
+	}
+	fmt.Fprintf(w, "| %d | %d %s | %s |", info.Code, info.HTTPStatus, http.StatusText(info.HTTPStatus), retryable)
+	for _, locale := range locales {
+		fmt.Fprintf(w, " %s |", escapeCell(info.Messages[locale]))
+	}
+	fmt.Fprintln(w)
+}
+
+// escapeCell keeps a message inside its table cell
+func escapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
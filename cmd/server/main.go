@@ -20,6 +20,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -45,6 +46,24 @@ func main() {
 
 	// Load configuration
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := cfg.LoadTargets(); err != nil {
+		log.Fatalf("Failed to load redis targets: %v", err)
+	}
+	if err := cfg.LoadACL(); err != nil {
+		log.Fatalf("Failed to load acl: %v", err)
+	}
+	if err := cfg.LoadRateLimit(); err != nil {
+		log.Fatalf("Failed to load rate limit: %v", err)
+	}
+	if err := cfg.LoadI18n(); err != nil {
+		log.Fatalf("Failed to load i18n: %v", err)
+	}
+	if err := errors.LoadCatalogs(cfg.I18n.CatalogDir); err != nil {
+		log.Fatalf("Failed to load message catalogs: %v", err)
+	}
 
 	// Initialize logger
 	loggerConfig := logger.LoggerConfig{
@@ -60,7 +79,7 @@ func main() {
 	logger.Info("Logger initialized successfully", nil)
 
 	// Initialize dependency injection container
-	appContainer, cleanup, err := container.InitializeContainer()
+	appContainer, cleanup, err := container.InitializeContainer(cfg)
 	if err != nil {
 		log.Fatalf("Failed to initialize container: %v", err)
 	}
@@ -81,10 +100,30 @@ func main() {
 
 	// Start server in a goroutine
 	addr := cfg.GetServerAddr()
+	server := &http.Server{
+		Addr:    addr,
+		Handler: engine,
+	}
+	if cfg.Server.TLS.Enabled {
+		reloader, err := newCertReloader(cfg.Server.TLS)
+		if err != nil {
+			log.Fatalf("Failed to load server certificate: %v", err)
+		}
+		server.TLSConfig = reloader.TLSConfig()
+		reloader.watchSIGHUP()
+	}
+
 	fmt.Printf("Server starting on %s\n", addr)
 	fmt.Println("=== Go Redis Proxy Server ===")
 	go func() {
-		if err := engine.Run(addr); err != nil {
+		var err error
+		if server.TLSConfig != nil {
+			// Certificates come from server.TLSConfig
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Printf("Server failed to start: %v", err)
 		}
 	}()
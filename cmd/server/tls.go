@@ -0,0 +1,92 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+)
+
+// certReloader holds the listener's TLS settings and reloads the certificate files on SIGHUP.
+// Established connections keep the certificate they were opened with.
+type certReloader struct {
+	cfg     config.ServerTLSConfig
+	current atomic.Pointer[tls.Config]
+}
+
+// newCertReloader loads the configured certificate, key and client CA
+func newCertReloader(cfg config.ServerTLSConfig) (*certReloader, error) {
+	r := &certReloader{cfg: cfg}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the certificate files again, the previous settings stay in use when loading fails
+func (r *certReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("load server certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if r.cfg.MinVersion == config.TLSVersion13 {
+		tlsConfig.MinVersion = tls.VersionTLS13
+	}
+
+	if r.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("read client ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", r.cfg.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		if r.cfg.ClientAuth == config.ClientAuthOptional {
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	r.current.Store(tlsConfig)
+	return nil
+}
+
+// TLSConfig returns the listener configuration, every handshake uses the latest loaded settings
+func (r *certReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		NextProtos: []string{"h2", "http/1.1"},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current.Load(), nil
+		},
+	}
+}
+
+// watchSIGHUP reloads the certificate files whenever the process receives SIGHUP
+func (r *certReloader) watchSIGHUP() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := r.Reload(); err != nil {
+				logger.Error("Failed to reload server certificate", logrus.Fields{"error": err.Error()})
+				continue
+			}
+			logger.Info("Server certificate reloaded", logrus.Fields{"cert_file": r.cfg.CertFile})
+		}
+	}()
+}
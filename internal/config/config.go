@@ -2,25 +2,314 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Server ServerConfig `yaml:"server"`
-	Redis  RedisConfig  `yaml:"redis"`
-	Log    LogConfig    `yaml:"log"`
+	Server   ServerConfig   `yaml:"server"`
+	Redis    RedisConfig    `yaml:"redis"`
+	Log      LogConfig      `yaml:"log"`
+	Script   ScriptConfig   `yaml:"script"`
+	Function FunctionConfig `yaml:"function"`
+
+	// Targets 命名Redis目标，请求通过target字段引用，从Redis.TargetsFile加载
+	Targets map[string]TargetConfig `yaml:"targets"`
+	// ACL 客户端证书身份可访问的路由组，从Server.ACLFile加载
+	ACL ACLConfig `yaml:"acl"`
+	// RateLimit 限流规则，从Server.RateLimitFile加载
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	// I18n 错误消息本地化配置，从Server.I18nFile加载
+	I18n I18nConfig `yaml:"i18n"`
 }
 
 type ServerConfig struct {
 	Host string `yaml:"host"`
 	Port int    `yaml:"port"`
+	Mode string `yaml:"mode"` // 运行模式：release（默认）或dev
+
+	TLS     ServerTLSConfig `yaml:"tls"`
+	ACLFile string          `yaml:"acl_file"` // 身份访问控制配置文件（YAML），为空时不限制，需要启用mTLS
+
+	RateLimitFile string `yaml:"rate_limit_file"` // 限流配置文件（YAML），为空时不限流
+
+	ErrorFormat     string `yaml:"error_format"`      // 默认错误响应格式：envelope（默认）或problem，客户端可通过Accept请求头选择
+	ProblemTypeBase string `yaml:"problem_type_base"` // problem+json中type URI的前缀，后接错误码
+
+	I18nFile string `yaml:"i18n_file"` // 错误消息本地化配置文件（YAML），为空时使用默认语言zh
+}
+
+// 错误响应格式
+const (
+	ErrorFormatEnvelope = "envelope" // BaseResponse结构
+	ErrorFormatProblem  = "problem"  // RFC 7807 application/problem+json
+)
+
+// 客户端证书校验方式
+const (
+	ClientAuthRequire  = "require"  // 必须提供有效的客户端证书
+	ClientAuthOptional = "optional" // 提供时校验，未提供的请求按匿名身份处理
+)
+
+// ServerTLSConfig HTTPS监听配置，收到SIGHUP时重新加载证书文件
+type ServerTLSConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	CertFile     string `yaml:"cert_file"`
+	KeyFile      string `yaml:"key_file"`
+	ClientCAFile string `yaml:"client_ca_file"` // 设置后启用mTLS，使用该CA校验客户端证书
+	ClientAuth   string `yaml:"client_auth"`    // require（默认）或optional
+	MinVersion   string `yaml:"min_version"`    // 最低TLS版本：1.2（默认）或1.3
+}
+
+// MTLS 是否校验客户端证书
+func (t ServerTLSConfig) MTLS() bool {
+	return t.Enabled && t.ClientCAFile != ""
+}
+
+// ACLConfig 按客户端证书身份限制可访问的路由组，"*"表示全部路由组
+type ACLConfig struct {
+	Identities map[string][]string `yaml:"identities"` // 身份到路由组列表的映射
+	Anonymous  []string            `yaml:"anonymous"`  // 未提供客户端证书的请求可访问的路由组
+}
+
+// Enabled 是否配置了访问控制
+func (a ACLConfig) Enabled() bool {
+	return a.Identities != nil || a.Anonymous != nil
+}
+
+// I18nConfig 错误消息本地化配置。请求依次按Accept-Language、API Key的默认语言和DefaultLocale选择语言
+type I18nConfig struct {
+	DefaultLocale string            `yaml:"default_locale"`  // 默认语言，默认zh
+	CatalogDir    string            `yaml:"catalog_dir"`     // 额外消息目录所在的目录，文件名为语言标签，如ja.yaml
+	APIKeyHeader  string            `yaml:"api_key_header"`  // API Key请求头，默认X-API-Key
+	APIKeyLocales map[string]string `yaml:"api_key_locales"` // API Key到默认语言的映射
+}
+
+// 限流状态存储
+const (
+	RateLimitStoreMemory = "memory" // 进程内存，只对当前实例生效
+	RateLimitStoreRedis  = "redis"  // 保存在命名目标中，多个代理实例共享
+)
+
+// 限流维度
+const (
+	RateLimitByCredential = "credential" // 凭证请求头
+	RateLimitByIdentity   = "identity"   // 客户端证书身份
+	RateLimitByIP         = "ip"         // 客户端IP
+	RateLimitByGroup      = "group"      // 路由组
+	RateLimitByTarget     = "target"     // 请求中的target，未设置时为addr
+)
+
+// RateLimitConfig 令牌桶限流配置
+type RateLimitConfig struct {
+	Store            string          `yaml:"store"`             // memory（默认）或redis
+	StoreTarget      string          `yaml:"store_target"`      // store为redis时保存令牌桶的命名目标
+	KeyPrefix        string          `yaml:"key_prefix"`        // Redis中令牌桶键的前缀，默认ratelimit:
+	CredentialHeader string          `yaml:"credential_header"` // 凭证请求头，默认X-API-Key
+	Rules            []RateLimitRule `yaml:"rules"`
+}
+
+// RateLimitRule 限流规则，按By中各维度的组合值分别计数，请求需要通过所有适用的规则
+type RateLimitRule struct {
+	Name   string   `yaml:"name"`
+	By     []string `yaml:"by"`     // 限流维度，任一维度取值为空时该规则不适用
+	Rate   float64  `yaml:"rate"`   // 每秒补充的令牌数
+	Burst  int      `yaml:"burst"`  // 令牌桶容量，默认为rate向上取整
+	Groups []string `yaml:"groups"` // 适用的路由组，为空时适用于全部路由组
+}
+
+// 运行模式
+const (
+	ModeRelease = "release"
+	ModeDev     = "dev" // 开发模式，允许跳过TLS证书校验等不安全配置
+)
+
+// DevMode 是否运行在开发模式
+func (s ServerConfig) DevMode() bool {
+	return s.Mode == ModeDev
 }
 
 type RedisConfig struct {
-	Host     string `yaml:"host"`
-	Port     int    `yaml:"port"`
-	Password string `yaml:"password"`
-	DB       int    `yaml:"db"`
+	Host        string `yaml:"host"`
+	Port        int    `yaml:"port"`
+	Password    string `yaml:"password"`
+	DB          int    `yaml:"db"`
+	TargetsFile string `yaml:"targets_file"` // 命名目标配置文件（YAML），为空时不启用命名目标
+
+	// ClientName 连接建立后通过CLIENT SETNAME设置的名称，便于在CLIENT LIST中识别，为空时不设置
+	ClientName string `yaml:"client_name"`
+	// TLS 请求中直接指定addr时使用的TLS配置，未启用时请求可通过tls字段单独开启
+	TLS TLSConfig `yaml:"tls"`
+	// Concurrency 每个Redis目标的并发限制，同时作为命名目标未配置时的默认值
+	Concurrency ConcurrencyConfig `yaml:"concurrency"`
+	// Breaker 每个Redis目标的熔断器，同时作为命名目标未配置时的默认值
+	Breaker BreakerConfig `yaml:"breaker"`
+	// Timeouts 连接与命令超时，同时作为命名目标未配置时的默认值
+	Timeouts TimeoutConfig `yaml:"timeouts"`
+	// Retry 只读命令的重试策略，同时作为命名目标未配置时的默认值
+	Retry RetryConfig `yaml:"retry"`
+	// RequestTimeoutMS 请求未指定timeout_ms时的整体超时（毫秒），0表示不限制
+	RequestTimeoutMS int `yaml:"request_timeout_ms"`
+	// MaxRequestTimeoutMS 请求可指定的timeout_ms上限（毫秒）
+	MaxRequestTimeoutMS int `yaml:"max_request_timeout_ms"`
+}
+
+// TimeoutConfig Redis连接超时（毫秒）
+type TimeoutConfig struct {
+	DialMS  int `yaml:"dial_ms"`  // 建立连接
+	ReadMS  int `yaml:"read_ms"`  // 读取单条命令的回复
+	WriteMS int `yaml:"write_ms"` // 发送单条命令
+	PoolMS  int `yaml:"pool_ms"`  // 连接池耗尽时等待空闲连接
+}
+
+// RetryConfig 只读命令的重试策略。只有GET、HGETALL等幂等的只读命令在连接断开、
+// 节点加载数据等临时错误后重试，写命令和超时的命令不会重试
+type RetryConfig struct {
+	MaxRetries   int `yaml:"max_retries"`    // 最大重试次数，0表示不重试
+	MinBackoffMS int `yaml:"min_backoff_ms"` // 首次重试前的退避时间（毫秒），之后逐次翻倍并加入随机抖动
+	MaxBackoffMS int `yaml:"max_backoff_ms"` // 退避时间上限（毫秒）
+}
+
+// ConcurrencyConfig 单个Redis目标的并发限制，超出并发的请求排队等待，队列满或等待超时时返回503
+type ConcurrencyConfig struct {
+	MaxConcurrent  *int `yaml:"max_concurrent"`   // 同时执行的最大请求数，0表示不限制，命名目标未填写时取自Redis.Concurrency
+	MaxQueue       *int `yaml:"max_queue"`        // 最大排队请求数，0表示不排队，命名目标未填写时取自Redis.Concurrency
+	QueueTimeoutMS int  `yaml:"queue_timeout_ms"` // 排队最长等待时间（毫秒）
+}
+
+// ConcurrentLimit 返回同时执行的最大请求数，0表示不限制
+func (cc ConcurrencyConfig) ConcurrentLimit() int {
+	if cc.MaxConcurrent == nil {
+		return 0
+	}
+	return *cc.MaxConcurrent
+}
+
+// QueueLimit 返回最大排队请求数
+func (cc ConcurrencyConfig) QueueLimit() int {
+	if cc.MaxQueue == nil {
+		return 0
+	}
+	return *cc.MaxQueue
+}
+
+// BreakerConfig 熔断器配置，统计最近window条命令的错误率和慢命令比例，超过阈值后熔断open_ms毫秒，
+// 之后进入半开状态放行half_open_probes个探测请求，全部成功后恢复
+type BreakerConfig struct {
+	Enabled        *bool `yaml:"enabled"`          // 是否启用，命名目标未填写时取自Redis.Breaker
+	Window         int   `yaml:"window"`           // 统计的最近命令数
+	MinRequests    int   `yaml:"min_requests"`     // 窗口内命令数达到该值后才计算比例
+	ErrorRate      int   `yaml:"error_rate"`       // 错误率阈值（%），连接失败、超时等计为错误，WRONGTYPE等命令错误不计入
+	SlowMS         int   `yaml:"slow_ms"`          // 慢命令阈值（毫秒），0表示不按延迟熔断
+	SlowRate       int   `yaml:"slow_rate"`        // 慢命令比例阈值（%）
+	OpenMS         int   `yaml:"open_ms"`          // 熔断持续时间（毫秒）
+	HalfOpenProbes int   `yaml:"half_open_probes"` // 半开状态放行的探测请求数
+}
+
+// IsEnabled 是否启用熔断器
+func (bc BreakerConfig) IsEnabled() bool {
+	return bc.Enabled != nil && *bc.Enabled
+}
+
+// TLS最低版本
+const (
+	TLSVersion12 = "1.2"
+	TLSVersion13 = "1.3"
+)
+
+// TLSConfig Redis连接的TLS配置，证书和私钥均为PEM文件路径
+type TLSConfig struct {
+	Enabled            bool   `yaml:"enabled"`
+	CAFile             string `yaml:"ca_file"`              // 自定义CA证书，为空时使用系统根证书
+	CertFile           string `yaml:"cert_file"`            // 客户端证书（mTLS），需与key_file同时配置
+	KeyFile            string `yaml:"key_file"`             // 客户端私钥
+	ServerName         string `yaml:"server_name"`          // 校验证书使用的服务器名，为空时使用连接地址的主机名
+	MinVersion         string `yaml:"min_version"`          // 最低TLS版本：1.2（默认）或1.3
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"` // 跳过证书校验，仅允许在dev模式下使用
+}
+
+// 目标类型
+const (
+	TargetTypeStandalone = "standalone"
+	TargetTypeSentinel   = "sentinel"
+	TargetTypeCluster    = "cluster"
+	TargetTypeSharded    = "sharded"
+)
+
+// sharded目标的一致性哈希算法
+const (
+	ShardHashKetama     = "ketama"
+	ShardHashRendezvous = "rendezvous"
+)
+
+// standalone目标的读路由策略
+const (
+	ReadPolicyPrimaryOnly      = "primary-only"      // 读写都发往主节点
+	ReadPolicyReplicaPreferred = "replica-preferred" // 读操作轮询健康副本，没有健康副本时发往主节点
+	ReadPolicyNearest          = "nearest"           // 读操作发往延迟最低的健康节点（含主节点）
+)
+
+// DefaultMaxReplicaLag 副本默认最大复制延迟（秒）
+const DefaultMaxReplicaLag = 5
+
+// TargetConfig 命名Redis目标配置
+type TargetConfig struct {
+	Type     string         `yaml:"type"`     // standalone（默认）、sentinel、cluster或sharded
+	Addr     string         `yaml:"addr"`     // standalone目标地址
+	Username string         `yaml:"username"` // Redis 6 ACL用户名，为空时使用default用户
+	Password string         `yaml:"password"`
+	DB       int            `yaml:"db"`  // cluster目标只支持DB 0
+	TLS      TLSConfig      `yaml:"tls"` // 目标所有节点共用，sentinel目标同时用于Sentinel节点
+	Sentinel SentinelConfig `yaml:"sentinel"`
+	Cluster  ClusterConfig  `yaml:"cluster"`
+	Sharded  ShardedConfig  `yaml:"sharded"`
+
+	// ClientName 通过CLIENT SETNAME设置的连接名，为空时使用Redis.ClientName
+	ClientName string `yaml:"client_name"`
+	// Concurrency 并发限制，未填写的项取自Redis.Concurrency，max_concurrent填0表示该目标不限制
+	Concurrency ConcurrencyConfig `yaml:"concurrency"`
+	// Breaker 熔断器，未配置时使用Redis.Breaker，未配置的阈值同样取自Redis.Breaker
+	Breaker *BreakerConfig `yaml:"breaker"`
+	// Timeouts 连接超时，为0的项使用Redis.Timeouts
+	Timeouts TimeoutConfig `yaml:"timeouts"`
+	// Retry 只读命令的重试策略，未配置时使用Redis.Retry
+	Retry *RetryConfig `yaml:"retry"`
+
+	// standalone目标的副本，沿用主节点的password和db
+	Replicas      []string `yaml:"replicas"`
+	ReadPolicy    string   `yaml:"read_policy"`     // 配置副本时默认replica-preferred，否则为primary-only
+	MaxReplicaLag int      `yaml:"max_replica_lag"` // 副本最大复制延迟（秒），超过后移出读轮询，默认5
+}
+
+// SentinelConfig Sentinel目标配置
+type SentinelConfig struct {
+	MasterName   string   `yaml:"master_name"`
+	Addrs        []string `yaml:"addrs"`         // Sentinel节点地址
+	Username     string   `yaml:"username"`      // Sentinel节点ACL用户名
+	Password     string   `yaml:"password"`      // Sentinel节点密码，与数据节点密码分开配置
+	ReplicaReads bool     `yaml:"replica_reads"` // 只读操作发往副本，没有可用副本时回落到主节点
+}
+
+// ShardedConfig 客户端分片目标配置，所有节点沿用目标的password和db
+type ShardedConfig struct {
+	Hash  string      `yaml:"hash"` // ketama（默认）或rendezvous
+	Nodes []ShardNode `yaml:"nodes"`
+}
+
+// ShardNode 分片节点，键按节点名哈希，更换节点地址不会改变键的分布
+type ShardNode struct {
+	Name string `yaml:"name"`
+	Addr string `yaml:"addr"`
+}
+
+// ClusterConfig Redis Cluster目标配置
+type ClusterConfig struct {
+	Addrs        []string `yaml:"addrs"`         // 种子节点地址，其余节点通过CLUSTER SLOTS发现
+	ReplicaReads bool     `yaml:"replica_reads"` // 只读操作发往各分片的副本
 }
 
 // LogConfig 日志配置
@@ -32,17 +321,94 @@ type LogConfig struct {
 	Compress bool   `yaml:"compress"` // 是否压缩旧日志文件
 }
 
+// ScriptConfig Lua脚本配置
+type ScriptConfig struct {
+	EnableEval bool          `yaml:"enable_eval"` // 是否允许临时脚本（EVAL、EVALSHA、SCRIPT LOAD/FLUSH），默认关闭
+	Dir        string        `yaml:"dir"`         // 命名脚本目录，<name>.lua以文件名作为脚本名
+	Disabled   []string      `yaml:"disabled"`    // 禁用的命名脚本
+	Scripts    []NamedScript `yaml:"scripts"`     // 直接在配置中定义的命名脚本
+}
+
+// NamedScript 配置中定义的命名脚本
+type NamedScript struct {
+	Name     string `yaml:"name"`
+	Source   string `yaml:"source"`
+	Disabled bool   `yaml:"disabled"`
+}
+
+// FunctionConfig Redis Functions配置
+type FunctionConfig struct {
+	Replicas map[string]string `yaml:"replicas"` // 主节点地址到只读副本地址的映射，FCALL_RO优先发往副本
+}
+
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
 			Host: getEnv("SERVER_HOST", "0.0.0.0"),
 			Port: getEnvInt("SERVER_PORT", 11779),
+			Mode: getEnv("SERVER_MODE", ModeRelease),
+			TLS: ServerTLSConfig{
+				Enabled:      getEnvBool("SERVER_TLS_ENABLED", false),
+				CertFile:     getEnv("SERVER_TLS_CERT_FILE", ""),
+				KeyFile:      getEnv("SERVER_TLS_KEY_FILE", ""),
+				ClientCAFile: getEnv("SERVER_TLS_CLIENT_CA_FILE", ""),
+				ClientAuth:   getEnv("SERVER_TLS_CLIENT_AUTH", ClientAuthRequire),
+				MinVersion:   getEnv("SERVER_TLS_MIN_VERSION", TLSVersion12),
+			},
+			ACLFile:       getEnv("SERVER_ACL_FILE", ""),
+			RateLimitFile: getEnv("SERVER_RATE_LIMIT_FILE", ""),
+
+			ErrorFormat:     getEnv("SERVER_ERROR_FORMAT", ErrorFormatEnvelope),
+			ProblemTypeBase: getEnv("SERVER_PROBLEM_TYPE_BASE", "urn:go-redis-proxy:error:"),
+
+			I18nFile: getEnv("SERVER_I18N_FILE", ""),
 		},
+		I18n: defaultI18n(),
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
 			Port:     getEnvInt("REDIS_PORT", 6379),
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       getEnvInt("REDIS_DB", 0),
+
+			TargetsFile: getEnv("REDIS_TARGETS_FILE", ""),
+			ClientName:  getEnv("REDIS_CLIENT_NAME", ""),
+			TLS: TLSConfig{
+				Enabled:            getEnvBool("REDIS_TLS_ENABLED", false),
+				CAFile:             getEnv("REDIS_TLS_CA_FILE", ""),
+				CertFile:           getEnv("REDIS_TLS_CERT_FILE", ""),
+				KeyFile:            getEnv("REDIS_TLS_KEY_FILE", ""),
+				ServerName:         getEnv("REDIS_TLS_SERVER_NAME", ""),
+				MinVersion:         getEnv("REDIS_TLS_MIN_VERSION", TLSVersion12),
+				InsecureSkipVerify: getEnvBool("REDIS_TLS_INSECURE_SKIP_VERIFY", false),
+			},
+			Concurrency: ConcurrencyConfig{
+				MaxConcurrent:  intPtr(getEnvInt("REDIS_MAX_CONCURRENT", 0)),
+				MaxQueue:       intPtr(getEnvInt("REDIS_MAX_QUEUE", 0)),
+				QueueTimeoutMS: getEnvInt("REDIS_QUEUE_TIMEOUT_MS", 1000),
+			},
+			Breaker: BreakerConfig{
+				Enabled:        boolPtr(getEnvBool("REDIS_BREAKER_ENABLED", true)),
+				Window:         getEnvInt("REDIS_BREAKER_WINDOW", 20),
+				MinRequests:    getEnvInt("REDIS_BREAKER_MIN_REQUESTS", 10),
+				ErrorRate:      getEnvInt("REDIS_BREAKER_ERROR_RATE", 50),
+				SlowMS:         getEnvInt("REDIS_BREAKER_SLOW_MS", 0),
+				SlowRate:       getEnvInt("REDIS_BREAKER_SLOW_RATE", 80),
+				OpenMS:         getEnvInt("REDIS_BREAKER_OPEN_MS", 5000),
+				HalfOpenProbes: getEnvInt("REDIS_BREAKER_HALF_OPEN_PROBES", 3),
+			},
+			Timeouts: TimeoutConfig{
+				DialMS:  getEnvInt("REDIS_DIAL_TIMEOUT_MS", 5000),
+				ReadMS:  getEnvInt("REDIS_READ_TIMEOUT_MS", 3000),
+				WriteMS: getEnvInt("REDIS_WRITE_TIMEOUT_MS", 3000),
+				PoolMS:  getEnvInt("REDIS_POOL_TIMEOUT_MS", 4000),
+			},
+			Retry: RetryConfig{
+				MaxRetries:   getEnvInt("REDIS_READ_RETRIES", 2),
+				MinBackoffMS: getEnvInt("REDIS_RETRY_MIN_BACKOFF_MS", 8),
+				MaxBackoffMS: getEnvInt("REDIS_RETRY_MAX_BACKOFF_MS", 512),
+			},
+			RequestTimeoutMS:    getEnvInt("REDIS_REQUEST_TIMEOUT_MS", 10000),
+			MaxRequestTimeoutMS: getEnvInt("REDIS_MAX_REQUEST_TIMEOUT_MS", 60000),
 		},
 		Log: LogConfig{
 			Level:    getEnv("LOG_LEVEL", "info"),
@@ -51,7 +417,442 @@ func Load() *Config {
 			MaxAge:   getEnvInt("LOG_MAX_AGE", 30),
 			Compress: getEnvBool("LOG_COMPRESS", true),
 		},
+		Script: ScriptConfig{
+			EnableEval: getEnvBool("SCRIPT_ENABLE_EVAL", false),
+			Dir:        getEnv("SCRIPT_DIR", "scripts"),
+			Disabled:   getEnvList("SCRIPT_DISABLED"),
+		},
+		Function: FunctionConfig{
+			Replicas: getEnvMap("FUNCTION_REPLICAS"),
+		},
+	}
+}
+
+// LoadACL 从Server.ACLFile加载身份访问控制配置
+func (c *Config) LoadACL() error {
+	if c.Server.ACLFile == "" {
+		return nil
+	}
+	if !c.Server.TLS.MTLS() {
+		return fmt.Errorf("acl file requires server tls with a client ca file")
+	}
+
+	data, err := os.ReadFile(c.Server.ACLFile)
+	if err != nil {
+		return fmt.Errorf("read acl file: %w", err)
+	}
+	var acl ACLConfig
+	if err := yaml.Unmarshal(data, &acl); err != nil {
+		return fmt.Errorf("parse acl file: %w", err)
+	}
+	if acl.Identities == nil {
+		acl.Identities = map[string][]string{}
+	}
+	c.ACL = acl
+	return nil
+}
+
+// LoadI18n 从Server.I18nFile加载错误消息本地化配置
+func (c *Config) LoadI18n() error {
+	if c.Server.I18nFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.Server.I18nFile)
+	if err != nil {
+		return fmt.Errorf("read i18n file: %w", err)
+	}
+	i18n := defaultI18n()
+	if err := yaml.Unmarshal(data, &i18n); err != nil {
+		return fmt.Errorf("parse i18n file: %w", err)
+	}
+	if i18n.DefaultLocale == "" || i18n.APIKeyHeader == "" {
+		return fmt.Errorf("i18n default_locale and api_key_header must not be empty")
+	}
+	c.I18n = i18n
+	return nil
+}
+
+// defaultI18n 未配置本地化文件时的默认配置
+func defaultI18n() I18nConfig {
+	return I18nConfig{
+		DefaultLocale: "zh",
+		APIKeyHeader:  "X-API-Key",
+	}
+}
+
+// LoadRateLimit 从Server.RateLimitFile加载限流配置并校验，需要在LoadTargets之后调用
+func (c *Config) LoadRateLimit() error {
+	if c.Server.RateLimitFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.Server.RateLimitFile)
+	if err != nil {
+		return fmt.Errorf("read rate limit file: %w", err)
+	}
+	var limit RateLimitConfig
+	if err := yaml.Unmarshal(data, &limit); err != nil {
+		return fmt.Errorf("parse rate limit file: %w", err)
+	}
+
+	if limit.Store == "" {
+		limit.Store = RateLimitStoreMemory
+	}
+	if limit.KeyPrefix == "" {
+		limit.KeyPrefix = "ratelimit:"
+	}
+	if limit.CredentialHeader == "" {
+		limit.CredentialHeader = "X-API-Key"
+	}
+	switch limit.Store {
+	case RateLimitStoreMemory:
+	case RateLimitStoreRedis:
+		if _, ok := c.Targets[limit.StoreTarget]; !ok {
+			return fmt.Errorf("rate limit store_target %q is not a configured target", limit.StoreTarget)
+		}
+	default:
+		return fmt.Errorf("unknown rate limit store %q", limit.Store)
 	}
+
+	names := make(map[string]bool, len(limit.Rules))
+	for i := range limit.Rules {
+		rule := &limit.Rules[i]
+		if rule.Name == "" || names[rule.Name] {
+			return fmt.Errorf("rate limit rules require unique names")
+		}
+		names[rule.Name] = true
+		if rule.Rate <= 0 {
+			return fmt.Errorf("rule %s: rate must be greater than 0", rule.Name)
+		}
+		if rule.Burst == 0 {
+			rule.Burst = int(math.Ceil(rule.Rate))
+		}
+		if rule.Burst < 1 {
+			return fmt.Errorf("rule %s: burst must be at least 1", rule.Name)
+		}
+		if len(rule.By) == 0 {
+			return fmt.Errorf("rule %s: by is required", rule.Name)
+		}
+		for _, by := range rule.By {
+			switch by {
+			case RateLimitByCredential, RateLimitByIdentity, RateLimitByIP, RateLimitByGroup, RateLimitByTarget:
+			default:
+				return fmt.Errorf("rule %s: unknown dimension %q", rule.Name, by)
+			}
+		}
+	}
+	c.RateLimit = limit
+	return nil
+}
+
+// LoadTargets 从Redis.TargetsFile加载命名目标并校验
+func (c *Config) LoadTargets() error {
+	if c.Redis.TargetsFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.Redis.TargetsFile)
+	if err != nil {
+		return fmt.Errorf("read targets file: %w", err)
+	}
+	var file struct {
+		Targets map[string]TargetConfig `yaml:"targets"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("parse targets file: %w", err)
+	}
+
+	for name, target := range file.Targets {
+		if target.Type == "" {
+			target.Type = TargetTypeStandalone
+		}
+		if target.ReadPolicy == "" {
+			target.ReadPolicy = ReadPolicyPrimaryOnly
+			if len(target.Replicas) > 0 {
+				target.ReadPolicy = ReadPolicyReplicaPreferred
+			}
+		}
+		if target.MaxReplicaLag == 0 {
+			target.MaxReplicaLag = DefaultMaxReplicaLag
+		}
+		if target.Sharded.Hash == "" {
+			target.Sharded.Hash = ShardHashKetama
+		}
+		if target.TLS.MinVersion == "" {
+			target.TLS.MinVersion = TLSVersion12
+		}
+		if target.ClientName == "" {
+			target.ClientName = c.Redis.ClientName
+		}
+		target.Concurrency = c.Redis.Concurrency.merge(target.Concurrency)
+		target.Breaker = c.Redis.Breaker.merge(target.Breaker)
+		target.Timeouts = c.Redis.Timeouts.merge(target.Timeouts)
+		target.Retry = c.Redis.Retry.merge(target.Retry)
+		if err := target.validate(c.Server.DevMode()); err != nil {
+			return fmt.Errorf("target %s: %w", name, err)
+		}
+		file.Targets[name] = target
+	}
+	c.Targets = file.Targets
+	return nil
+}
+
+// Validate 校验不依赖命名目标的配置
+func (c *Config) Validate() error {
+	switch c.Server.Mode {
+	case ModeRelease, ModeDev:
+	default:
+		return fmt.Errorf("unknown server mode %q", c.Server.Mode)
+	}
+	switch c.Server.ErrorFormat {
+	case ErrorFormatEnvelope, ErrorFormatProblem:
+	default:
+		return fmt.Errorf("unknown server error_format %q", c.Server.ErrorFormat)
+	}
+	if err := c.Server.TLS.validate(); err != nil {
+		return fmt.Errorf("server: %w", err)
+	}
+	if err := c.Redis.TLS.validate(c.Server.DevMode()); err != nil {
+		return fmt.Errorf("redis: %w", err)
+	}
+	if err := c.Redis.Concurrency.validate(); err != nil {
+		return fmt.Errorf("redis: %w", err)
+	}
+	if err := c.Redis.Breaker.validate(); err != nil {
+		return fmt.Errorf("redis: %w", err)
+	}
+	if err := c.Redis.Timeouts.validate(); err != nil {
+		return fmt.Errorf("redis: %w", err)
+	}
+	if err := c.Redis.Retry.validate(); err != nil {
+		return fmt.Errorf("redis: %w", err)
+	}
+	if c.Redis.MaxRequestTimeoutMS <= 0 || c.Redis.RequestTimeoutMS < 0 || c.Redis.RequestTimeoutMS > c.Redis.MaxRequestTimeoutMS {
+		return fmt.Errorf("redis: request_timeout_ms must be between 0 and max_request_timeout_ms")
+	}
+	return nil
+}
+
+// merge 返回目标的超时配置，为0的项取默认值
+func (tc TimeoutConfig) merge(target TimeoutConfig) TimeoutConfig {
+	if target.DialMS == 0 {
+		target.DialMS = tc.DialMS
+	}
+	if target.ReadMS == 0 {
+		target.ReadMS = tc.ReadMS
+	}
+	if target.WriteMS == 0 {
+		target.WriteMS = tc.WriteMS
+	}
+	if target.PoolMS == 0 {
+		target.PoolMS = tc.PoolMS
+	}
+	return target
+}
+
+// validate 检查超时配置
+func (tc TimeoutConfig) validate() error {
+	if tc.DialMS <= 0 || tc.ReadMS <= 0 || tc.WriteMS <= 0 || tc.PoolMS <= 0 {
+		return fmt.Errorf("timeouts must be positive")
+	}
+	return nil
+}
+
+// merge 返回目标的重试策略，未配置的退避时间取默认值
+func (rc RetryConfig) merge(target *RetryConfig) *RetryConfig {
+	if target == nil {
+		return &rc
+	}
+	merged := *target
+	if merged.MinBackoffMS == 0 {
+		merged.MinBackoffMS = rc.MinBackoffMS
+	}
+	if merged.MaxBackoffMS == 0 {
+		merged.MaxBackoffMS = rc.MaxBackoffMS
+	}
+	return &merged
+}
+
+// validate 检查重试策略
+func (rc RetryConfig) validate() error {
+	if rc.MaxRetries < 0 {
+		return fmt.Errorf("retry.max_retries must not be negative")
+	}
+	if rc.MinBackoffMS <= 0 || rc.MaxBackoffMS < rc.MinBackoffMS {
+		return fmt.Errorf("retry.min_backoff_ms must be positive and not above retry.max_backoff_ms")
+	}
+	return nil
+}
+
+// merge 返回目标的熔断器配置，未填写的enabled和阈值取默认值
+func (bc BreakerConfig) merge(target *BreakerConfig) *BreakerConfig {
+	if target == nil {
+		return &bc
+	}
+	merged := *target
+	if merged.Enabled == nil {
+		merged.Enabled = bc.Enabled
+	}
+	if merged.Window == 0 {
+		merged.Window = bc.Window
+	}
+	if merged.MinRequests == 0 {
+		merged.MinRequests = bc.MinRequests
+	}
+	if merged.ErrorRate == 0 {
+		merged.ErrorRate = bc.ErrorRate
+	}
+	if merged.SlowMS == 0 {
+		merged.SlowMS = bc.SlowMS
+	}
+	if merged.SlowRate == 0 {
+		merged.SlowRate = bc.SlowRate
+	}
+	if merged.OpenMS == 0 {
+		merged.OpenMS = bc.OpenMS
+	}
+	if merged.HalfOpenProbes == 0 {
+		merged.HalfOpenProbes = bc.HalfOpenProbes
+	}
+	return &merged
+}
+
+// validate 检查熔断器阈值
+func (bc BreakerConfig) validate() error {
+	if !bc.IsEnabled() {
+		return nil
+	}
+	if bc.Window <= 0 || bc.MinRequests <= 0 || bc.MinRequests > bc.Window {
+		return fmt.Errorf("breaker.min_requests must be between 1 and breaker.window")
+	}
+	if bc.ErrorRate <= 0 || bc.ErrorRate > 100 || bc.SlowRate <= 0 || bc.SlowRate > 100 {
+		return fmt.Errorf("breaker.error_rate and breaker.slow_rate must be between 1 and 100")
+	}
+	if bc.SlowMS < 0 || bc.OpenMS <= 0 || bc.HalfOpenProbes <= 0 {
+		return fmt.Errorf("breaker.open_ms and breaker.half_open_probes must be positive")
+	}
+	return nil
+}
+
+// merge 返回目标的并发限制，未填写的项取默认值
+func (cc ConcurrencyConfig) merge(target ConcurrencyConfig) ConcurrencyConfig {
+	if target.MaxConcurrent == nil {
+		target.MaxConcurrent = cc.MaxConcurrent
+	}
+	if target.MaxQueue == nil {
+		target.MaxQueue = cc.MaxQueue
+	}
+	if target.QueueTimeoutMS == 0 {
+		target.QueueTimeoutMS = cc.QueueTimeoutMS
+	}
+	return target
+}
+
+// validate 检查并发限制
+func (cc ConcurrencyConfig) validate() error {
+	if cc.ConcurrentLimit() < 0 || cc.QueueLimit() < 0 || cc.QueueTimeoutMS < 0 {
+		return fmt.Errorf("concurrency limits must not be negative")
+	}
+	return nil
+}
+
+// validate 检查HTTPS监听配置
+func (t ServerTLSConfig) validate() error {
+	if !t.Enabled {
+		return nil
+	}
+	if t.CertFile == "" || t.KeyFile == "" {
+		return fmt.Errorf("tls.cert_file and tls.key_file are required")
+	}
+	switch t.ClientAuth {
+	case ClientAuthRequire, ClientAuthOptional:
+	default:
+		return fmt.Errorf("unknown tls.client_auth %q", t.ClientAuth)
+	}
+	switch t.MinVersion {
+	case TLSVersion12, TLSVersion13:
+	default:
+		return fmt.Errorf("unknown tls.min_version %q", t.MinVersion)
+	}
+	return nil
+}
+
+// validate 检查目标类型所需的字段
+func (t TargetConfig) validate(devMode bool) error {
+	if err := t.TLS.validate(devMode); err != nil {
+		return err
+	}
+	if err := t.Concurrency.validate(); err != nil {
+		return err
+	}
+	if err := t.Breaker.validate(); err != nil {
+		return err
+	}
+	if err := t.Timeouts.validate(); err != nil {
+		return err
+	}
+	if err := t.Retry.validate(); err != nil {
+		return err
+	}
+	switch t.Type {
+	case TargetTypeStandalone:
+		if t.Addr == "" {
+			return fmt.Errorf("addr is required")
+		}
+		switch t.ReadPolicy {
+		case ReadPolicyPrimaryOnly, ReadPolicyReplicaPreferred, ReadPolicyNearest:
+		default:
+			return fmt.Errorf("unknown read_policy %q", t.ReadPolicy)
+		}
+	case TargetTypeSentinel:
+		if t.Sentinel.MasterName == "" || len(t.Sentinel.Addrs) == 0 {
+			return fmt.Errorf("sentinel.master_name and sentinel.addrs are required")
+		}
+	case TargetTypeCluster:
+		if len(t.Cluster.Addrs) == 0 {
+			return fmt.Errorf("cluster.addrs is required")
+		}
+		if t.DB != 0 {
+			return fmt.Errorf("cluster targets only support db 0")
+		}
+	case TargetTypeSharded:
+		if len(t.Sharded.Nodes) == 0 {
+			return fmt.Errorf("sharded.nodes is required")
+		}
+		if t.Sharded.Hash != ShardHashKetama && t.Sharded.Hash != ShardHashRendezvous {
+			return fmt.Errorf("unknown sharded.hash %q", t.Sharded.Hash)
+		}
+		names := make(map[string]bool, len(t.Sharded.Nodes))
+		for _, node := range t.Sharded.Nodes {
+			if node.Name == "" || node.Addr == "" {
+				return fmt.Errorf("sharded nodes require name and addr")
+			}
+			if names[node.Name] {
+				return fmt.Errorf("duplicate shard name %q", node.Name)
+			}
+			names[node.Name] = true
+		}
+	default:
+		return fmt.Errorf("unknown type %q", t.Type)
+	}
+	return nil
+}
+
+// validate 检查TLS配置，跳过证书校验只允许在dev模式下使用
+func (t TLSConfig) validate(devMode bool) error {
+	if t.InsecureSkipVerify && !devMode {
+		return fmt.Errorf("tls.insecure_skip_verify is only allowed in dev mode")
+	}
+	if (t.CertFile == "") != (t.KeyFile == "") {
+		return fmt.Errorf("tls.cert_file and tls.key_file must be set together")
+	}
+	switch t.MinVersion {
+	case TLSVersion12, TLSVersion13:
+	default:
+		return fmt.Errorf("unknown tls.min_version %q", t.MinVersion)
+	}
+	return nil
 }
 
 func (c *Config) GetServerAddr() string {
@@ -65,15 +866,26 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvInt 读取整数环境变量，未设置或无法解析时返回默认值，0和负数按原值返回
 func getEnvInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
-		if intValue := parseInt(value); intValue != 0 {
+		if intValue, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
 			return intValue
 		}
 	}
 	return defaultValue
 }
 
+// boolPtr 返回bool值的指针，用于区分未配置和false
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+// intPtr 返回int值的指针，用于区分未配置和0
+func intPtr(v int) *int {
+	return &v
+}
+
 func getEnvBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		return value == "true" || value == "1" || value == "yes"
@@ -81,14 +893,25 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
-func parseInt(s string) int {
-	var result int
-	for _, char := range s {
-		if char >= '0' && char <= '9' {
-			result = result*10 + int(char-'0')
-		} else {
-			return 0
+// getEnvList 读取逗号分隔的列表，忽略空项
+func getEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvMap 读取逗号分隔的key=value列表，忽略格式错误的项
+func getEnvMap(key string) map[string]string {
+	m := make(map[string]string)
+	for _, item := range getEnvList(key) {
+		k, v, ok := strings.Cut(item, "=")
+		if k, v = strings.TrimSpace(k), strings.TrimSpace(v); ok && k != "" && v != "" {
+			m[k] = v
 		}
 	}
-	return result
+	return m
 }
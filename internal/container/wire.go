@@ -4,8 +4,10 @@
 package container
 
 import (
+	"github.com/ct-zh/go-redis-proxy/internal/config"
 	"github.com/ct-zh/go-redis-proxy/internal/dao"
 	"github.com/ct-zh/go-redis-proxy/internal/handler"
+	"github.com/ct-zh/go-redis-proxy/internal/middleware"
 	"github.com/ct-zh/go-redis-proxy/internal/service"
 	"github.com/google/wire"
 )
@@ -16,22 +18,47 @@ type Container struct {
 	RedisDAO dao.RedisDAO
 
 	// Service layer
-	StringService service.RedisStringService
-	ListService   service.RedisListService
-	SetService    service.RedisSetService
-	ZSetService   service.RedisZSetService
-	HashService   service.RedisHashService
+	StringService   service.RedisStringService
+	ListService     service.RedisListService
+	SetService      service.RedisSetService
+	ZSetService     service.RedisZSetService
+	HashService     service.RedisHashService
+	BitmapService   service.RedisBitmapService
+	HLLService      service.RedisHLLService
+	GeoService      service.RedisGeoService
+	ScriptService   service.RedisScriptService
+	FunctionService service.RedisFunctionService
+	PipelineService service.RedisPipelineService
+	HealthService   service.HealthService
 
 	// Handler layer
-	RedisHandler      *handler.RedisHandler
-	RedisListHandler  *handler.RedisListHandler
-	RedisSetHandler   *handler.RedisSetHandler
-	RedisZSetHandler  *handler.RedisZSetHandler
-	RedisHashHandler  *handler.RedisHashHandler
+	RedisHandler         *handler.RedisHandler
+	RedisListHandler     *handler.RedisListHandler
+	RedisSetHandler      *handler.RedisSetHandler
+	RedisZSetHandler     *handler.RedisZSetHandler
+	RedisHashHandler     *handler.RedisHashHandler
+	RedisBitmapHandler   *handler.RedisBitmapHandler
+	RedisHLLHandler      *handler.RedisHLLHandler
+	RedisGeoHandler      *handler.RedisGeoHandler
+	RedisScriptHandler   *handler.RedisScriptHandler
+	RedisFunctionHandler *handler.RedisFunctionHandler
+	RedisPipelineHandler *handler.RedisPipelineHandler
+	HealthHandler        *handler.HealthHandler
+
+	// Middleware
+	ACL                *middleware.ACL
+	RateLimiter        *middleware.RateLimiter
+	ConcurrencyLimiter *middleware.ConcurrencyLimiter
+	RequestDeadline    *middleware.RequestDeadline
+	ErrorFormat        *middleware.ErrorFormat
+	Locale             *middleware.Locale
 }
 
 // buildProvider
 var buildProvider = wire.NewSet(
+	wire.FieldsOf(new(*config.Config), "Server", "Redis", "Script", "Function", "Targets", "ACL", "RateLimit", "I18n"),
+
+	dao.NewTargetManager,
 	wire.Bind(new(dao.RedisDAO), new(*dao.RedisDAOImpl)),
 	dao.NewRedisDAO,
 
@@ -43,18 +70,39 @@ var buildProvider = wire.NewSet(
 	service.NewRedisSetService,
 	service.NewRedisZSetService,
 	service.NewRedisHashService,
+	service.NewRedisBitmapService,
+	service.NewRedisHLLService,
+	service.NewRedisGeoService,
+	service.NewScriptRegistry,
+	service.NewRedisScriptService,
+	service.NewRedisFunctionService,
+	service.NewRedisPipelineService,
+	service.NewHealthService,
 
 	handler.NewRedisHandler,
 	handler.NewRedisListHandler,
 	handler.NewRedisSetHandler,
 	handler.NewRedisZSetHandler,
 	handler.NewRedisHashHandler,
+	handler.NewRedisBitmapHandler,
+	handler.NewRedisHLLHandler,
+	handler.NewRedisGeoHandler,
+	handler.NewRedisScriptHandler,
+	handler.NewRedisFunctionHandler,
+	handler.NewRedisPipelineHandler,
+	handler.NewHealthHandler,
+
+	middleware.NewACL,
+	middleware.NewRateLimiter,
+	middleware.NewConcurrencyLimiter,
+	middleware.NewRequestDeadline,
+	middleware.NewErrorFormat,
+	middleware.NewLocale,
 
 	wire.Struct(new(Container), "*"),
 )
 
-func InitializeContainer() (*Container, func(), error) {
+func InitializeContainer(cfg *config.Config) (*Container, func(), error) {
 	wire.Build(buildProvider)
 	return nil, nil, nil
 }
-
@@ -7,40 +7,112 @@
 package container
 
 import (
+	"github.com/ct-zh/go-redis-proxy/internal/config"
 	"github.com/ct-zh/go-redis-proxy/internal/dao"
 	"github.com/ct-zh/go-redis-proxy/internal/handler"
+	"github.com/ct-zh/go-redis-proxy/internal/middleware"
 	"github.com/ct-zh/go-redis-proxy/internal/service"
 	"github.com/google/wire"
 )
 
 // Injectors from wire.go:
 
-func InitializeContainer() (*Container, func(), error) {
-	redisDAOImpl := dao.NewRedisDAO()
+func InitializeContainer(cfg *config.Config) (*Container, func(), error) {
+	v := cfg.Targets
+	redisConfig := cfg.Redis
+	targetManager, cleanup, err := dao.NewTargetManager(v, redisConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisDAOImpl, err := dao.NewRedisDAO(targetManager, redisConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	redisStringServiceImpl := service.NewRedisStringService(redisDAOImpl)
 	redisListServiceImpl := service.NewRedisListService(redisDAOImpl)
 	redisSetServiceImpl := service.NewRedisSetService(redisDAOImpl)
 	redisZSetService := service.NewRedisZSetService(redisDAOImpl)
 	redisHashService := service.NewRedisHashService(redisDAOImpl)
+	redisBitmapService := service.NewRedisBitmapService(redisDAOImpl)
+	redisHLLService := service.NewRedisHLLService(redisDAOImpl)
+	redisGeoService := service.NewRedisGeoService(redisDAOImpl)
+	scriptConfig := cfg.Script
+	scriptRegistry, err := service.NewScriptRegistry(scriptConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	redisScriptService := service.NewRedisScriptService(redisDAOImpl, scriptRegistry, scriptConfig)
+	functionConfig := cfg.Function
+	redisFunctionService := service.NewRedisFunctionService(redisDAOImpl, functionConfig)
+	redisPipelineService := service.NewRedisPipelineService(redisDAOImpl)
+	healthService := service.NewHealthService(targetManager)
 	redisHandler := handler.NewRedisHandler(redisStringServiceImpl, redisListServiceImpl)
 	redisListHandler := handler.NewRedisListHandler(redisListServiceImpl)
 	redisSetHandler := handler.NewRedisSetHandler(redisSetServiceImpl)
 	redisZSetHandler := handler.NewRedisZSetHandler(redisZSetService)
 	redisHashHandler := handler.NewRedisHashHandler(redisHashService)
+	redisBitmapHandler := handler.NewRedisBitmapHandler(redisBitmapService)
+	redisHLLHandler := handler.NewRedisHLLHandler(redisHLLService)
+	redisGeoHandler := handler.NewRedisGeoHandler(redisGeoService)
+	redisScriptHandler := handler.NewRedisScriptHandler(redisScriptService)
+	redisFunctionHandler := handler.NewRedisFunctionHandler(redisFunctionService)
+	redisPipelineHandler := handler.NewRedisPipelineHandler(redisPipelineService)
+	healthHandler := handler.NewHealthHandler(healthService)
+	aclConfig := cfg.ACL
+	acl := middleware.NewACL(aclConfig)
+	rateLimitConfig := cfg.RateLimit
+	rateLimiter, err := middleware.NewRateLimiter(rateLimitConfig, targetManager)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	concurrencyLimiter := middleware.NewConcurrencyLimiter(redisConfig, v)
+	requestDeadline := middleware.NewRequestDeadline(redisConfig)
+	serverConfig := cfg.Server
+	errorFormat := middleware.NewErrorFormat(serverConfig)
+	i18nConfig := cfg.I18n
+	locale, err := middleware.NewLocale(i18nConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	container := &Container{
-		RedisDAO:         redisDAOImpl,
-		StringService:    redisStringServiceImpl,
-		ListService:      redisListServiceImpl,
-		SetService:       redisSetServiceImpl,
-		ZSetService:      redisZSetService,
-		HashService:      redisHashService,
-		RedisHandler:     redisHandler,
-		RedisListHandler: redisListHandler,
-		RedisSetHandler:  redisSetHandler,
-		RedisZSetHandler: redisZSetHandler,
-		RedisHashHandler: redisHashHandler,
+		RedisDAO:             redisDAOImpl,
+		StringService:        redisStringServiceImpl,
+		ListService:          redisListServiceImpl,
+		SetService:           redisSetServiceImpl,
+		ZSetService:          redisZSetService,
+		HashService:          redisHashService,
+		BitmapService:        redisBitmapService,
+		HLLService:           redisHLLService,
+		GeoService:           redisGeoService,
+		ScriptService:        redisScriptService,
+		FunctionService:      redisFunctionService,
+		PipelineService:      redisPipelineService,
+		HealthService:        healthService,
+		RedisHandler:         redisHandler,
+		RedisListHandler:     redisListHandler,
+		RedisSetHandler:      redisSetHandler,
+		RedisZSetHandler:     redisZSetHandler,
+		RedisHashHandler:     redisHashHandler,
+		RedisBitmapHandler:   redisBitmapHandler,
+		RedisHLLHandler:      redisHLLHandler,
+		RedisGeoHandler:      redisGeoHandler,
+		RedisScriptHandler:   redisScriptHandler,
+		RedisFunctionHandler: redisFunctionHandler,
+		RedisPipelineHandler: redisPipelineHandler,
+		HealthHandler:        healthHandler,
+		ACL:                  acl,
+		RateLimiter:          rateLimiter,
+		ConcurrencyLimiter:   concurrencyLimiter,
+		RequestDeadline:      requestDeadline,
+		ErrorFormat:          errorFormat,
+		Locale:               locale,
 	}
 	return container, func() {
+		cleanup()
 	}, nil
 }
 
@@ -52,19 +124,41 @@ type Container struct {
 	RedisDAO dao.RedisDAO
 
 	// Service layer
-	StringService service.RedisStringService
-	ListService   service.RedisListService
-	SetService    service.RedisSetService
-	ZSetService   service.RedisZSetService
-	HashService   service.RedisHashService
+	StringService   service.RedisStringService
+	ListService     service.RedisListService
+	SetService      service.RedisSetService
+	ZSetService     service.RedisZSetService
+	HashService     service.RedisHashService
+	BitmapService   service.RedisBitmapService
+	HLLService      service.RedisHLLService
+	GeoService      service.RedisGeoService
+	ScriptService   service.RedisScriptService
+	FunctionService service.RedisFunctionService
+	PipelineService service.RedisPipelineService
+	HealthService   service.HealthService
 
 	// Handler layer
-	RedisHandler     *handler.RedisHandler
-	RedisListHandler *handler.RedisListHandler
-	RedisSetHandler  *handler.RedisSetHandler
-	RedisZSetHandler *handler.RedisZSetHandler
-	RedisHashHandler *handler.RedisHashHandler
+	RedisHandler         *handler.RedisHandler
+	RedisListHandler     *handler.RedisListHandler
+	RedisSetHandler      *handler.RedisSetHandler
+	RedisZSetHandler     *handler.RedisZSetHandler
+	RedisHashHandler     *handler.RedisHashHandler
+	RedisBitmapHandler   *handler.RedisBitmapHandler
+	RedisHLLHandler      *handler.RedisHLLHandler
+	RedisGeoHandler      *handler.RedisGeoHandler
+	RedisScriptHandler   *handler.RedisScriptHandler
+	RedisFunctionHandler *handler.RedisFunctionHandler
+	RedisPipelineHandler *handler.RedisPipelineHandler
+	HealthHandler        *handler.HealthHandler
+
+	// Middleware
+	ACL                *middleware.ACL
+	RateLimiter        *middleware.RateLimiter
+	ConcurrencyLimiter *middleware.ConcurrencyLimiter
+	RequestDeadline    *middleware.RequestDeadline
+	ErrorFormat        *middleware.ErrorFormat
+	Locale             *middleware.Locale
 }
 
 // buildProvider
-var buildProvider = wire.NewSet(wire.Bind(new(dao.RedisDAO), new(*dao.RedisDAOImpl)), dao.NewRedisDAO, wire.Bind(new(service.RedisStringService), new(*service.RedisStringServiceImpl)), service.NewRedisStringService, wire.Bind(new(service.RedisListService), new(*service.RedisListServiceImpl)), service.NewRedisListService, wire.Bind(new(service.RedisSetService), new(*service.RedisSetServiceImpl)), service.NewRedisSetService, service.NewRedisZSetService, service.NewRedisHashService, handler.NewRedisHandler, handler.NewRedisListHandler, handler.NewRedisSetHandler, handler.NewRedisZSetHandler, handler.NewRedisHashHandler, wire.Struct(new(Container), "*"))
+var buildProvider = wire.NewSet(wire.FieldsOf(new(*config.Config), "Server", "Redis", "Script", "Function", "Targets", "ACL", "RateLimit", "I18n"), dao.NewTargetManager, wire.Bind(new(dao.RedisDAO), new(*dao.RedisDAOImpl)), dao.NewRedisDAO, wire.Bind(new(service.RedisStringService), new(*service.RedisStringServiceImpl)), service.NewRedisStringService, wire.Bind(new(service.RedisListService), new(*service.RedisListServiceImpl)), service.NewRedisListService, wire.Bind(new(service.RedisSetService), new(*service.RedisSetServiceImpl)), service.NewRedisSetService, service.NewRedisZSetService, service.NewRedisHashService, service.NewRedisBitmapService, service.NewRedisHLLService, service.NewRedisGeoService, service.NewScriptRegistry, service.NewRedisScriptService, service.NewRedisFunctionService, service.NewRedisPipelineService, service.NewHealthService, handler.NewRedisHandler, handler.NewRedisListHandler, handler.NewRedisSetHandler, handler.NewRedisZSetHandler, handler.NewRedisHashHandler, handler.NewRedisBitmapHandler, handler.NewRedisHLLHandler, handler.NewRedisGeoHandler, handler.NewRedisScriptHandler, handler.NewRedisFunctionHandler, handler.NewRedisPipelineHandler, handler.NewHealthHandler, middleware.NewACL, middleware.NewRateLimiter, middleware.NewConcurrencyLimiter, middleware.NewRequestDeadline, middleware.NewErrorFormat, middleware.NewLocale, wire.Struct(new(Container), "*"))
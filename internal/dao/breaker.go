@@ -0,0 +1,403 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/metrics"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// Circuit breaker states
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+)
+
+const (
+	// addrTargetPrefix names the breakers of connections made from a request addr
+	addrTargetPrefix = "addr:"
+	// breakerIdleTimeout is how long an unused closed addr breaker is kept
+	breakerIdleTimeout = 10 * time.Minute
+)
+
+func init() {
+	metrics.Register("redis_target_breaker_state", "Circuit breaker state per Redis target: 0 closed, 1 open, 2 half-open.", metrics.KindGauge)
+	metrics.Register("redis_target_breaker_transitions_total", "Circuit breaker state changes per Redis target.", metrics.KindCounter)
+}
+
+// BreakerOpenError is returned by Connect while the circuit breaker of a target rejects requests
+type BreakerOpenError struct {
+	Target     string
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *BreakerOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker of %s is open, retry after %s", e.Target, e.RetryAfter)
+}
+
+// breaker trips when too many of the recent commands of a target fail or are slow,
+// rejects requests while open and lets a few probes through once the open period elapsed
+type breaker struct {
+	name string
+	cfg  config.BreakerConfig
+
+	mu    sync.Mutex
+	state string
+	since time.Time // when the current state was entered
+	used  time.Time
+	// generation changes with every transition, outcomes of commands and requests
+	// that started in an earlier generation are ignored
+	generation atomic.Uint64
+
+	// outcomes is a ring of the last cfg.Window commands seen while closed
+	outcomes []outcome
+	next     int
+	count    int
+	failures int
+	slow     int
+
+	// probes and passed count the requests admitted and completed while half-open
+	probes int
+	passed int
+}
+
+// breakerTicket is handed to a request admitted by a breaker, probe marks a half-open probe
+type breakerTicket struct {
+	breaker    *breaker
+	generation uint64
+	probe      bool
+}
+
+// outcome is the result of a single command or pipeline
+type outcome struct {
+	failed bool
+	slow   bool
+}
+
+// newBreaker creates a closed breaker, nil when the breaker is disabled
+func newBreaker(name string, cfg config.BreakerConfig) *breaker {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	b := &breaker{
+		name:     name,
+		cfg:      cfg,
+		state:    BreakerClosed,
+		since:    time.Now(),
+		used:     time.Now(),
+		outcomes: make([]outcome, cfg.Window),
+	}
+	b.report()
+	return b
+}
+
+// allow reports whether a request may use the target, moving an expired open breaker to half-open.
+// The returned ticket must be completed with done once the request finished.
+func (b *breaker) allow() (breakerTicket, error) {
+	if b == nil {
+		return breakerTicket{}, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.used = now
+	openFor := time.Duration(b.cfg.OpenMS) * time.Millisecond
+
+	switch b.state {
+	case BreakerOpen:
+		if elapsed := now.Sub(b.since); elapsed < openFor {
+			return breakerTicket{}, &BreakerOpenError{Target: b.name, RetryAfter: openFor - elapsed}
+		}
+		b.transition(BreakerHalfOpen, "open period elapsed")
+	case BreakerHalfOpen:
+		// Probes that never reported back, e.g. canceled requests, must not wedge the breaker
+		if b.probes >= b.cfg.HalfOpenProbes && now.Sub(b.since) >= openFor {
+			b.transition(BreakerHalfOpen, "probes timed out")
+		}
+	}
+
+	ticket := breakerTicket{breaker: b, generation: b.generation.Load()}
+	if b.state == BreakerHalfOpen {
+		if b.probes >= b.cfg.HalfOpenProbes {
+			return breakerTicket{}, &BreakerOpenError{Target: b.name, RetryAfter: openFor}
+		}
+		b.probes++
+		ticket.probe = true
+	}
+	return ticket, nil
+}
+
+// done completes the request of a ticket. A probe passes when the request completed
+// without its commands reopening the breaker, the breaker closes once all probes passed.
+// completed is false when the request gave up before its outcome was known.
+func (t breakerTicket) done(completed bool) {
+	if !t.probe || !completed {
+		return
+	}
+	b := t.breaker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != BreakerHalfOpen || b.generation.Load() != t.generation {
+		return
+	}
+	b.passed++
+	if b.passed >= b.cfg.HalfOpenProbes {
+		b.transition(BreakerClosed, "probes succeeded")
+	}
+}
+
+// record adds the outcome of a command started in the given generation,
+// tripping the breaker as needed
+func (b *breaker) record(generation uint64, failed bool, latency time.Duration) {
+	slow := b.cfg.SlowMS > 0 && latency >= time.Duration(b.cfg.SlowMS)*time.Millisecond
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.generation.Load() != generation {
+		// Started before the last transition, e.g. admitted while closed and finishing while half-open
+		return
+	}
+
+	switch b.state {
+	case BreakerHalfOpen:
+		// Successful probes are counted per request by done
+		switch {
+		case failed:
+			b.transition(BreakerOpen, "probe failed")
+		case slow:
+			b.transition(BreakerOpen, fmt.Sprintf("probe took %s", latency))
+		}
+	case BreakerClosed:
+		b.push(outcome{failed: failed, slow: slow})
+		if b.count < b.cfg.MinRequests {
+			return
+		}
+		if b.failures*100 >= b.cfg.ErrorRate*b.count {
+			b.transition(BreakerOpen, fmt.Sprintf("%d of %d commands failed", b.failures, b.count))
+		} else if b.cfg.SlowMS > 0 && b.slow*100 >= b.cfg.SlowRate*b.count {
+			b.transition(BreakerOpen, fmt.Sprintf("%d of %d commands took over %dms", b.slow, b.count, b.cfg.SlowMS))
+		}
+	}
+}
+
+// push appends an outcome to the window, evicting the oldest one once full
+func (b *breaker) push(o outcome) {
+	if b.count == len(b.outcomes) {
+		old := b.outcomes[b.next]
+		if old.failed {
+			b.failures--
+		}
+		if old.slow {
+			b.slow--
+		}
+	} else {
+		b.count++
+	}
+	b.outcomes[b.next] = o
+	b.next = (b.next + 1) % len(b.outcomes)
+	if o.failed {
+		b.failures++
+	}
+	if o.slow {
+		b.slow++
+	}
+}
+
+// transition enters a new state with an empty window, the caller holds the lock
+func (b *breaker) transition(state, reason string) {
+	from := b.state
+	b.state = state
+	b.since = time.Now()
+	b.next, b.count, b.failures, b.slow = 0, 0, 0, 0
+	b.probes, b.passed = 0, 0
+	b.generation.Add(1)
+	if from == state {
+		return
+	}
+
+	b.report()
+	metrics.Inc("redis_target_breaker_transitions_total", metrics.Labels{"target": b.name, "state": state})
+	fields := logrus.Fields{
+		"target": b.name,
+		"from":   from,
+		"to":     state,
+		"reason": reason,
+	}
+	if state == BreakerOpen {
+		logger.Warn("Redis circuit breaker opened", fields)
+	} else {
+		logger.Info("Redis circuit breaker state changed", fields)
+	}
+}
+
+// report publishes the current state as a gauge
+func (b *breaker) report() {
+	value := 0.0
+	switch b.state {
+	case BreakerOpen:
+		value = 1
+	case BreakerHalfOpen:
+		value = 2
+	}
+	metrics.Set("redis_target_breaker_state", metrics.Labels{"target": b.name}, value)
+}
+
+// status returns a snapshot of the breaker
+func (b *breaker) status() types.BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := types.BreakerStatus{
+		Target:   b.name,
+		State:    b.state,
+		Since:    b.since.UTC().Format(time.RFC3339),
+		Requests: b.count,
+		Failures: b.failures,
+		Slow:     b.slow,
+	}
+	if b.state == BreakerOpen {
+		remaining := time.Duration(b.cfg.OpenMS)*time.Millisecond - time.Since(b.since)
+		if remaining > 0 {
+			s.RetryAfterMS = remaining.Milliseconds()
+		}
+	}
+	return s
+}
+
+// breakerHook feeds the outcome of every command sent through a client into its breaker
+type breakerHook struct {
+	breaker *breaker
+}
+
+type breakerStartKey struct{}
+
+// breakerStart is when a command was sent and the breaker generation at that time
+type breakerStart struct {
+	at         time.Time
+	generation uint64
+}
+
+func (h breakerHook) start(ctx context.Context) context.Context {
+	return context.WithValue(ctx, breakerStartKey{}, breakerStart{at: time.Now(), generation: h.breaker.generation.Load()})
+}
+
+func (h breakerHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return h.start(ctx), nil
+}
+
+func (h breakerHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.observe(ctx, cmd.Err())
+	return nil
+}
+
+func (h breakerHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return h.start(ctx), nil
+}
+
+func (h breakerHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if targetFailure(cmd.Err()) {
+			err = cmd.Err()
+			break
+		}
+	}
+	h.observe(ctx, err)
+	return nil
+}
+
+func (h breakerHook) observe(ctx context.Context, err error) {
+	start, ok := ctx.Value(breakerStartKey{}).(breakerStart)
+	if !ok || ctx.Err() != nil {
+		// A caller giving up or running out of its own deadline, e.g. timeout_ms or a
+		// blocking command waiting longer than the request may, says nothing about the target
+		return
+	}
+	h.breaker.record(start.generation, targetFailure(err), time.Since(start.at))
+}
+
+// targetFailure reports whether an error means the target itself is unhealthy.
+// Command errors such as WRONGTYPE are the caller's problem and do not count,
+// except for the replies of a server that cannot serve any command right now.
+func targetFailure(err error) bool {
+	if err == nil || err == redis.Nil {
+		return false
+	}
+	switch ClassifyError(err) {
+	case ErrorTimeout, ErrorCanceled, ErrorConnection, ErrorLoading, ErrorMasterDown, ErrorClusterDown:
+		return true
+	}
+	return false
+}
+
+// addrBreakers holds the breakers of connections made from a request addr,
+// created on first use and dropped once closed and idle
+type addrBreakers struct {
+	cfg config.BreakerConfig
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+	swept    time.Time
+}
+
+// get returns the breaker of an address, nil when breakers are disabled
+func (a *addrBreakers) get(addr string) *breaker {
+	if !a.cfg.IsEnabled() {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if time.Since(a.swept) >= breakerIdleTimeout {
+		a.sweep()
+	}
+	b, ok := a.breakers[addr]
+	if !ok {
+		b = newBreaker(addrTargetPrefix+addr, a.cfg)
+		a.breakers[addr] = b
+	}
+	return b
+}
+
+// sweep drops idle closed breakers so that arbitrary request addrs do not pile up, the caller holds the lock
+func (a *addrBreakers) sweep() {
+	a.swept = time.Now()
+	for addr, b := range a.breakers {
+		b.mu.Lock()
+		idle := b.state == BreakerClosed && time.Since(b.used) >= breakerIdleTimeout
+		b.mu.Unlock()
+		if idle {
+			delete(a.breakers, addr)
+		}
+	}
+}
+
+// statuses returns the state of every addr breaker sorted by name
+func (a *addrBreakers) statuses() []types.BreakerStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	statuses := make([]types.BreakerStatus, 0, len(a.breakers))
+	for _, b := range a.breakers {
+		statuses = append(statuses, b.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Target < statuses[j].Target
+	})
+	return statuses
+}
@@ -0,0 +1,267 @@
+package dao
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	// Breaker transitions are logged, keep the log files out of the source tree
+	dir, err := os.MkdirTemp("", "dao-test-logs")
+	if err != nil {
+		panic(err)
+	}
+	if err := logger.Init(logger.LoggerConfig{Level: "error", Dir: dir}); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func testBreakerConfig() config.BreakerConfig {
+	enabled := true
+	return config.BreakerConfig{
+		Enabled:        &enabled,
+		Window:         10,
+		MinRequests:    5,
+		ErrorRate:      50,
+		SlowMS:         100,
+		SlowRate:       50,
+		OpenMS:         1000,
+		HalfOpenProbes: 2,
+	}
+}
+
+// recordAll feeds outcomes into the current generation of a breaker
+func recordAll(b *breaker, outcomes ...outcome) {
+	for _, o := range outcomes {
+		latency := time.Millisecond
+		if o.slow {
+			latency = time.Second
+		}
+		b.record(b.generation.Load(), o.failed, latency)
+	}
+}
+
+// elapseOpen moves the start of the current state back by the open period
+func elapseOpen(b *breaker) {
+	b.mu.Lock()
+	b.since = b.since.Add(-time.Duration(b.cfg.OpenMS) * time.Millisecond)
+	b.mu.Unlock()
+}
+
+func breakerState(b *breaker) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// tripped returns an open breaker whose open period elapsed
+func tripped(t *testing.T) *breaker {
+	t.Helper()
+	b := newBreaker("test", testBreakerConfig())
+	recordAll(b, outcome{failed: true}, outcome{failed: true}, outcome{failed: true}, outcome{failed: true}, outcome{failed: true})
+	if state := breakerState(b); state != BreakerOpen {
+		t.Fatalf("state = %s, want %s", state, BreakerOpen)
+	}
+	elapseOpen(b)
+	return b
+}
+
+func TestBreakerTrip(t *testing.T) {
+	ok, failed, slow := outcome{}, outcome{failed: true}, outcome{slow: true}
+	tests := []struct {
+		name     string
+		window   int
+		outcomes []outcome
+		want     string
+	}{
+		{"below min_requests", 10, []outcome{failed, failed, failed, failed}, BreakerClosed},
+		{"error rate reached at min_requests", 10, []outcome{ok, ok, failed, failed, failed}, BreakerOpen},
+		{"error rate below threshold", 10, []outcome{ok, ok, ok, failed, failed}, BreakerClosed},
+		{"slow rate reached", 10, []outcome{ok, ok, slow, slow, slow}, BreakerOpen},
+		{"slow rate below threshold", 10, []outcome{ok, ok, ok, slow, slow}, BreakerClosed},
+		{"failures evicted from the window", 5, []outcome{failed, failed, ok, ok, ok, ok, ok, failed, failed}, BreakerClosed},
+		{"failures within the window", 5, []outcome{ok, ok, ok, ok, ok, ok, failed, failed, failed}, BreakerOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testBreakerConfig()
+			cfg.Window = tt.window
+			b := newBreaker("test", cfg)
+			recordAll(b, tt.outcomes...)
+			if state := breakerState(b); state != tt.want {
+				t.Fatalf("state = %s, want %s", state, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreakerSlowDisabled(t *testing.T) {
+	cfg := testBreakerConfig()
+	cfg.SlowMS = 0
+	b := newBreaker("test", cfg)
+	recordAll(b, outcome{slow: true}, outcome{slow: true}, outcome{slow: true}, outcome{slow: true}, outcome{slow: true})
+	if state := breakerState(b); state != BreakerClosed {
+		t.Fatalf("state = %s, want %s without slow_ms", state, BreakerClosed)
+	}
+}
+
+func TestBreakerOpenFailsFast(t *testing.T) {
+	b := newBreaker("test", testBreakerConfig())
+	recordAll(b, outcome{failed: true}, outcome{failed: true}, outcome{failed: true}, outcome{failed: true}, outcome{failed: true})
+
+	_, err := b.allow()
+	var openErr *BreakerOpenError
+	if !errors.As(err, &openErr) {
+		t.Fatalf("allow() error = %v, want *BreakerOpenError", err)
+	}
+	if openErr.Target != "test" {
+		t.Errorf("Target = %q, want %q", openErr.Target, "test")
+	}
+	if openErr.RetryAfter <= 0 || openErr.RetryAfter > time.Second {
+		t.Errorf("RetryAfter = %s, want within the open period of 1s", openErr.RetryAfter)
+	}
+	if state := breakerState(b); state != BreakerOpen {
+		t.Fatalf("state = %s, want %s", state, BreakerOpen)
+	}
+}
+
+func TestBreakerHalfOpenProbes(t *testing.T) {
+	b := tripped(t)
+
+	var tickets []breakerTicket
+	for i := 0; i < b.cfg.HalfOpenProbes; i++ {
+		ticket, err := b.allow()
+		if err != nil {
+			t.Fatalf("probe %d: allow() error = %v", i, err)
+		}
+		if !ticket.probe {
+			t.Fatalf("probe %d: ticket is not a probe", i)
+		}
+		tickets = append(tickets, ticket)
+	}
+	if state := breakerState(b); state != BreakerHalfOpen {
+		t.Fatalf("state = %s, want %s", state, BreakerHalfOpen)
+	}
+
+	// Only half_open_probes requests are let through
+	var openErr *BreakerOpenError
+	if _, err := b.allow(); !errors.As(err, &openErr) {
+		t.Fatalf("allow() beyond the probe limit error = %v, want *BreakerOpenError", err)
+	}
+
+	// A probe that gave up does not count as passed
+	tickets[0].done(false)
+	tickets[0].done(true)
+	if state := breakerState(b); state != BreakerHalfOpen {
+		t.Fatalf("state = %s after one passed probe, want %s", state, BreakerHalfOpen)
+	}
+	tickets[1].done(true)
+	if state := breakerState(b); state != BreakerClosed {
+		t.Fatalf("state = %s after all probes passed, want %s", state, BreakerClosed)
+	}
+}
+
+func TestBreakerProbeFailureReopens(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		failed  bool
+		latency time.Duration
+	}{
+		{"failed", true, time.Millisecond},
+		{"slow", false, time.Second},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tripped(t)
+			ticket, err := b.allow()
+			if err != nil {
+				t.Fatalf("allow() error = %v", err)
+			}
+			b.record(ticket.generation, tt.failed, tt.latency)
+			if state := breakerState(b); state != BreakerOpen {
+				t.Fatalf("state = %s, want %s", state, BreakerOpen)
+			}
+			// The ticket belongs to the generation before reopening
+			ticket.done(true)
+			if state := breakerState(b); state != BreakerOpen {
+				t.Fatalf("state = %s after a stale probe completed, want %s", state, BreakerOpen)
+			}
+		})
+	}
+}
+
+func TestBreakerIgnoresStaleGeneration(t *testing.T) {
+	b := newBreaker("test", testBreakerConfig())
+	closedGeneration := b.generation.Load()
+	closedTicket, err := b.allow()
+	if err != nil {
+		t.Fatalf("allow() error = %v", err)
+	}
+
+	recordAll(b, outcome{failed: true}, outcome{failed: true}, outcome{failed: true}, outcome{failed: true}, outcome{failed: true})
+	elapseOpen(b)
+	probe, err := b.allow()
+	if err != nil {
+		t.Fatalf("allow() error = %v", err)
+	}
+
+	// A command admitted while closed that fails while half-open must not reopen the breaker
+	b.record(closedGeneration, true, time.Millisecond)
+	if state := breakerState(b); state != BreakerHalfOpen {
+		t.Fatalf("state = %s after a stale failure, want %s", state, BreakerHalfOpen)
+	}
+	closedTicket.done(true)
+
+	probe.done(true)
+	if state := breakerState(b); state != BreakerHalfOpen {
+		t.Fatalf("state = %s, want %s until half_open_probes passed", state, BreakerHalfOpen)
+	}
+}
+
+func TestBreakerProbesTimeOut(t *testing.T) {
+	b := tripped(t)
+	for i := 0; i < b.cfg.HalfOpenProbes; i++ {
+		if _, err := b.allow(); err != nil {
+			t.Fatalf("probe %d: allow() error = %v", i, err)
+		}
+	}
+	if _, err := b.allow(); err == nil {
+		t.Fatalf("allow() beyond the probe limit succeeded")
+	}
+
+	// The probes never report back, once another open period elapsed new probes are let through
+	generation := b.generation.Load()
+	elapseOpen(b)
+	ticket, err := b.allow()
+	if err != nil {
+		t.Fatalf("allow() after the probes timed out error = %v", err)
+	}
+	if !ticket.probe || ticket.generation == generation {
+		t.Fatalf("ticket = %+v, want a probe of a new generation", ticket)
+	}
+	if state := breakerState(b); state != BreakerHalfOpen {
+		t.Fatalf("state = %s, want %s", state, BreakerHalfOpen)
+	}
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	cfg := testBreakerConfig()
+	disabled := false
+	cfg.Enabled = &disabled
+	b := newBreaker("test", cfg)
+	if b != nil {
+		t.Fatalf("newBreaker() = %v, want nil when disabled", b)
+	}
+	ticket, err := b.allow()
+	if err != nil || ticket.probe {
+		t.Fatalf("allow() on a nil breaker = %+v, %v, want an empty ticket", ticket, err)
+	}
+	ticket.done(true)
+}
@@ -0,0 +1,171 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+// clusterSlots is the number of hash slots in a Redis Cluster
+const clusterSlots = 16384
+
+// hashTag returns the part of a key that decides its placement: the content
+// of the first non-empty {hash tag}, or the whole key
+func hashTag(key string) string {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return key[start+1 : start+1+end]
+		}
+	}
+	return key
+}
+
+// keySlot returns the Redis Cluster hash slot of a key
+func keySlot(key string) int {
+	return int(crc16(hashTag(key)) % clusterSlots)
+}
+
+// slotGroup names the hash slot of a key, used to group the keys of cluster targets
+func slotGroup(key string) string {
+	return strconv.Itoa(keySlot(key))
+}
+
+// crc16 implements CRC16-CCITT (XMODEM), the checksum used for key slots
+func crc16(key string) uint16 {
+	var crc uint16
+	for i := 0; i < len(key); i++ {
+		crc ^= uint16(key[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// keyGroups groups key indexes by hash slot or shard, in the order the groups first appear
+func (r *RedisConnImpl) keyGroups(keys []string) [][]int {
+	positions := make(map[string]int)
+	var groups [][]int
+	for i, key := range keys {
+		group := r.keyGroup(key)
+		pos, ok := positions[group]
+		if !ok {
+			pos = len(groups)
+			positions[group] = pos
+			groups = append(groups, nil)
+		}
+		groups[pos] = append(groups[pos], i)
+	}
+	return groups
+}
+
+// groupKeys returns the keys of each slot group
+func groupKeys(keys []string, groups [][]int) [][]string {
+	grouped := make([][]string, len(groups))
+	for i, group := range groups {
+		grouped[i] = make([]string, len(group))
+		for j, index := range group {
+			grouped[i][j] = keys[index]
+		}
+	}
+	return grouped
+}
+
+// Colocated reports whether the keys may be used together in one atomic
+// command: they share a hash slot on cluster targets and a node on sharded
+// targets. It is always true for single-node targets.
+func (r *RedisConnImpl) Colocated(keys ...string) bool {
+	if r.keyGroup == nil || len(keys) < 2 {
+		return true
+	}
+	group := r.keyGroup(keys[0])
+	for _, key := range keys[1:] {
+		if r.keyGroup(key) != group {
+			return false
+		}
+	}
+	return true
+}
+
+// forEachPrimary runs fn on every primary of a cluster target or every live node of a sharded target,
+// fanned is false when the client has a single primary and fn was not run
+func (r *RedisConnImpl) forEachPrimary(ctx context.Context, fn func(ctx context.Context, client *redis.Client) error) (fanned bool, err error) {
+	switch client := r.client.(type) {
+	case *redis.ClusterClient:
+		return true, client.ForEachMaster(ctx, fn)
+	case *redis.Ring:
+		return true, client.ForEachShard(ctx, fn)
+	}
+	return false, nil
+}
+
+// fannedStatus is the status reply of a command run on every primary
+func fannedStatus(err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return "OK", nil
+}
+
+// setsByGroup runs a multi-key set command once per key group in a single pipeline
+func (r *RedisConnImpl) setsByGroup(ctx context.Context, command string, keys []string) ([][]string, error) {
+	groups := groupKeys(keys, r.keyGroups(keys))
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, len(groups))
+	for i, group := range groups {
+		args := make([]interface{}, 0, len(group)+1)
+		args = append(args, command)
+		for _, key := range group {
+			args = append(args, key)
+		}
+		cmds[i] = redis.NewStringSliceCmd(ctx, args...)
+		_ = pipe.Process(ctx, cmds[i])
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	sets := make([][]string, len(cmds))
+	for i, cmd := range cmds {
+		sets[i] = cmd.Val()
+	}
+	return sets, nil
+}
+
+// intersectMembers returns the members present in every set, in the order of the first set
+func intersectMembers(sets [][]string) []string {
+	counts := make(map[string]int)
+	for _, set := range sets {
+		for _, member := range set {
+			counts[member]++
+		}
+	}
+	members := []string{}
+	for _, member := range sets[0] {
+		if counts[member] == len(sets) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// unionMembers returns the distinct members of all sets in first-seen order
+func unionMembers(sets [][]string) []string {
+	seen := make(map[string]bool)
+	members := []string{}
+	for _, set := range sets {
+		for _, member := range set {
+			if !seen[member] {
+				seen[member] = true
+				members = append(members, member)
+			}
+		}
+	}
+	return members
+}
@@ -0,0 +1,54 @@
+package dao
+
+import "testing"
+
+func TestCRC16(t *testing.T) {
+	// Check value of CRC16-CCITT (XMODEM) from the Redis Cluster specification
+	if got := crc16("123456789"); got != 0x31C3 {
+		t.Fatalf("crc16(123456789) = %#x, want 0x31c3", got)
+	}
+}
+
+func TestHashTag(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"foo", "foo"},
+		{"{user1000}.following", "user1000"},
+		{"foo{bar}{zap}", "bar"},
+		{"foo{}{bar}", "foo{}{bar}"},
+		{"foo{{bar}}zap", "{bar"},
+		{"foo{bar", "foo{bar"},
+		{"foo}bar{", "foo}bar{"},
+	}
+	for _, tt := range tests {
+		if got := hashTag(tt.key); got != tt.want {
+			t.Errorf("hashTag(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestKeySlot(t *testing.T) {
+	// Slots as reported by CLUSTER KEYSLOT
+	tests := []struct {
+		key  string
+		want int
+	}{
+		{"foo", 12182},
+		{"bar", 5061},
+		{"hello", 866},
+		{"123456789", 12739},
+		{"{foo}.bar", 12182},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := keySlot(tt.key); got != tt.want {
+			t.Errorf("keySlot(%q) = %d, want %d", tt.key, got, tt.want)
+		}
+	}
+
+	if keySlot("{user1000}.following") != keySlot("{user1000}.followers") {
+		t.Errorf("keys with the same hash tag map to different slots")
+	}
+}
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+// ErrorKind is the class of a failed Redis call
+type ErrorKind int
+
+// Error kinds reported by ClassifyError
+const (
+	// ErrorCommand is any other error reply, such as a syntax error or a value that is not an integer
+	ErrorCommand ErrorKind = iota
+	// ErrorTimeout covers dial, read, write and pool timeouts and passed request deadlines
+	ErrorTimeout
+	// ErrorCanceled means the caller gave up
+	ErrorCanceled
+	// ErrorConnection is any other failure of the client: a refused, reset or closed connection or a malformed reply
+	ErrorConnection
+	// ErrorWrongType is WRONGTYPE, the key holds another data type
+	ErrorWrongType
+	// ErrorAuth is NOAUTH, WRONGPASS or NOPERM
+	ErrorAuth
+	// ErrorDBSelect is a SELECT of a database the server does not have
+	ErrorDBSelect
+	// ErrorOOM is OOM, maxmemory is reached and writes are rejected
+	ErrorOOM
+	// ErrorReadOnly is READONLY, a write was sent to a replica
+	ErrorReadOnly
+	// ErrorBusy is BUSY, a script or function is running too long
+	ErrorBusy
+	// ErrorLoading is LOADING, the server is loading its dataset
+	ErrorLoading
+	// ErrorMasterDown is MASTERDOWN, a replica lost its master and refuses stale reads
+	ErrorMasterDown
+	// ErrorRedirect is MOVED or ASK, the slot is served by another node
+	ErrorRedirect
+	// ErrorTryAgain is TRYAGAIN, the keys of a multi-key command are split by a slot migration
+	ErrorTryAgain
+	// ErrorClusterDown is CLUSTERDOWN, the cluster cannot serve the slot
+	ErrorClusterDown
+)
+
+// ClassifyError tells apart the failures of a Redis call that callers handle differently.
+// It must not be called with a nil error or redis.Nil.
+func ClassifyError(err error) ErrorKind {
+	if errors.Is(err, context.Canceled) {
+		return ErrorCanceled
+	}
+	if IsTimeout(err) {
+		return ErrorTimeout
+	}
+
+	var redisErr redis.Error
+	if errors.As(err, &redisErr) {
+		return classifyReply(redisErr.Error())
+	}
+	return ErrorConnection
+}
+
+// classifyReply classifies a RESP error reply by its error code, the first word of the reply
+func classifyReply(msg string) ErrorKind {
+	prefix, _, _ := strings.Cut(msg, " ")
+	switch prefix {
+	case "WRONGTYPE":
+		return ErrorWrongType
+	case "NOAUTH", "WRONGPASS", "NOPERM":
+		return ErrorAuth
+	case "OOM":
+		return ErrorOOM
+	case "READONLY":
+		return ErrorReadOnly
+	case "BUSY":
+		return ErrorBusy
+	case "LOADING":
+		return ErrorLoading
+	case "MASTERDOWN":
+		return ErrorMasterDown
+	case "MOVED", "ASK":
+		return ErrorRedirect
+	case "TRYAGAIN":
+		return ErrorTryAgain
+	case "CLUSTERDOWN":
+		return ErrorClusterDown
+	}
+	// Servers before Redis 6 reply to AUTH with a plain ERR
+	if strings.HasPrefix(msg, "ERR invalid password") || strings.HasPrefix(msg, "ERR Client sent AUTH, but no password is set") {
+		return ErrorAuth
+	}
+	if strings.HasPrefix(msg, "ERR DB index is out of range") || strings.HasPrefix(msg, "ERR invalid DB index") {
+		return ErrorDBSelect
+	}
+	return ErrorCommand
+}
+
+// RedirectSlot returns the hash slot named by a MOVED or ASK reply, -1 for other errors.
+// The node address in the reply is internal and must not be shown to clients.
+func RedirectSlot(err error) int {
+	var redisErr redis.Error
+	if !errors.As(err, &redisErr) {
+		return -1
+	}
+	fields := strings.Fields(redisErr.Error())
+	if len(fields) < 2 || (fields[0] != "MOVED" && fields[0] != "ASK") {
+		return -1
+	}
+	slot, convErr := strconv.Atoi(fields[1])
+	if convErr != nil {
+		return -1
+	}
+	return slot
+}
@@ -7,15 +7,24 @@ import (
 	"github.com/ct-zh/go-redis-proxy/pkg/types"
 )
 
-// RedisDAO defines the data access interface for Redis operations
+// RedisDAO creates the Redis connections of requests
 type RedisDAO interface {
+	Connect(ctx context.Context, config types.RedisRequest) (RedisConn, error)
+	ConnectRead(ctx context.Context, config types.RedisRequest) (RedisConn, error)
+}
+
+// RedisConn defines the data access interface for Redis operations on the
+// connection of one request, which must be closed when the request is done
+type RedisConn interface {
 	// Connection management
-	Connect(config types.RedisRequest) error
 	Close() error
 	Ping(ctx context.Context) error
+	ServerVersion(ctx context.Context) (string, error)
+	Colocated(keys ...string) bool
 
 	// String operations
 	StringGet(ctx context.Context, key string) (interface{}, error)
+	StringMGet(ctx context.Context, keys []string) ([]interface{}, error)
 	StringSet(ctx context.Context, key string, value interface{}, ttl time.Duration) (string, error)
 	StringDel(ctx context.Context, key string) (int64, error)
 	StringExists(ctx context.Context, key string) (bool, error)
@@ -40,9 +49,21 @@ type RedisDAO interface {
 	SetSIsMember(ctx context.Context, key string, member string) (bool, error)
 	SetSMembers(ctx context.Context, key string) ([]string, error)
 	SetSCard(ctx context.Context, key string) (int64, error)
+	SetSInter(ctx context.Context, keys []string) ([]string, error)
+	SetSInterStore(ctx context.Context, destination string, keys []string) (int64, error)
+	SetSInterCard(ctx context.Context, keys []string, limit int64) (int64, error)
+	SetSUnion(ctx context.Context, keys []string) ([]string, error)
+	SetSUnionStore(ctx context.Context, destination string, keys []string) (int64, error)
+	SetSDiff(ctx context.Context, keys []string) ([]string, error)
+	SetSDiffStore(ctx context.Context, destination string, keys []string) (int64, error)
+	SetSMove(ctx context.Context, source, destination string, member string) (bool, error)
+	SetSPop(ctx context.Context, key string, count int64) ([]string, error)
+	SetSRandMember(ctx context.Context, key string, count int64) ([]string, error)
+	SetSMIsMember(ctx context.Context, key string, members []string) ([]bool, error)
 
 	// ZSet operations
-	ZSetZAdd(ctx context.Context, key string, members map[string]float64) (int64, error)
+	ZSetZAdd(ctx context.Context, key string, members []types.ZSetMember, flags types.ZAddFlags) (int64, error)
+	ZSetZAddIncr(ctx context.Context, key string, member types.ZSetMember, flags types.ZAddFlags) (interface{}, error)
 	ZSetZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error)
 	ZSetZScore(ctx context.Context, key string, member string) (interface{}, error)
 	ZSetZCard(ctx context.Context, key string) (int64, error)
@@ -56,6 +77,21 @@ type RedisDAO interface {
 	ZSetZRem(ctx context.Context, key string, members []string) (int64, error)
 	ZSetZRemRangeByRank(ctx context.Context, key string, start, stop int64) (int64, error)
 	ZSetZRemRangeByScore(ctx context.Context, key string, min, max string) (int64, error)
+	ZSetZRangeArgs(ctx context.Context, key string, opt ZRangeOptions, withScores bool) ([]interface{}, error)
+	ZSetZRangeByLex(ctx context.Context, key string, min, max string, offset, count int64) ([]string, error)
+	ZSetZLexCount(ctx context.Context, key string, min, max string) (int64, error)
+	ZSetZRemRangeByLex(ctx context.Context, key string, min, max string) (int64, error)
+	ZSetZPopMin(ctx context.Context, key string, count int64) ([]types.ZSetMember, error)
+	ZSetZPopMax(ctx context.Context, key string, count int64) ([]types.ZSetMember, error)
+	ZSetBZPopMin(ctx context.Context, keys []string, timeout time.Duration) (string, *types.ZSetMember, error)
+	ZSetZMScore(ctx context.Context, key string, members []string) ([]interface{}, error)
+	ZSetZRandMember(ctx context.Context, key string, count int64, withScores bool) ([]types.ZSetMember, error)
+	ZSetZUnion(ctx context.Context, keys []string, weights []float64, aggregate string, withScores bool) ([]types.ZSetMember, error)
+	ZSetZUnionStore(ctx context.Context, destination string, keys []string, weights []float64, aggregate string) (int64, error)
+	ZSetZInter(ctx context.Context, keys []string, weights []float64, aggregate string, withScores bool) ([]types.ZSetMember, error)
+	ZSetZInterStore(ctx context.Context, destination string, keys []string, weights []float64, aggregate string) (int64, error)
+	ZSetZDiff(ctx context.Context, keys []string, withScores bool) ([]types.ZSetMember, error)
+	ZSetZDiffStore(ctx context.Context, destination string, keys []string) (int64, error)
 
 	// Hash operations
 	HashHSet(ctx context.Context, key string, fields map[string]string) (int64, error)
@@ -68,11 +104,72 @@ type RedisDAO interface {
 	HashHKeys(ctx context.Context, key string) ([]string, error)
 	HashHVals(ctx context.Context, key string) ([]string, error)
 	HashHIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error)
+	HashHSetNX(ctx context.Context, key string, field string, value string) (bool, error)
+	HashHIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error)
+	HashHStrLen(ctx context.Context, key string, field string) (int64, error)
+	HashHRandField(ctx context.Context, key string, count int64, withValues bool) ([]types.HashField, error)
+	HashHExpire(ctx context.Context, key string, ttl time.Duration, condition string, fields []string) ([]int64, error)
+	HashHTTL(ctx context.Context, key string, fields []string) ([]int64, error)
+	HashHPersist(ctx context.Context, key string, fields []string) ([]int64, error)
+
+	// Bitmap operations
+	BitmapSetBit(ctx context.Context, key string, offset int64, value int) (int64, error)
+	BitmapGetBit(ctx context.Context, key string, offset int64) (int64, error)
+	BitmapBitCount(ctx context.Context, key string, start, end *int64, unit string) (int64, error)
+	BitmapBitPos(ctx context.Context, key string, bit int64, start, end *int64, unit string) (int64, error)
+	BitmapBitOp(ctx context.Context, operation, destination string, keys []string) (int64, error)
+	BitmapBitField(ctx context.Context, key string, readOnly bool, operations []types.BitFieldOperation) ([]interface{}, error)
+
+	// HyperLogLog operations
+	HLLPFAdd(ctx context.Context, key string, elements []string) (int64, error)
+	HLLPFCount(ctx context.Context, keys []string) (int64, error)
+	HLLPFMerge(ctx context.Context, destination string, keys []string) (string, error)
+
+	// Geo operations
+	GeoAdd(ctx context.Context, key string, locations []types.GeoLocation, flags types.GeoAddFlags) (int64, error)
+	GeoPos(ctx context.Context, key string, members []string) ([]*types.GeoPosition, error)
+	GeoDist(ctx context.Context, key string, member1, member2, unit string) (*float64, error)
+	GeoHash(ctx context.Context, key string, members []string) ([]string, error)
+	GeoSearch(ctx context.Context, key string, opt types.GeoSearchOptions, withCoord, withDist bool) ([]types.GeoSearchLocation, error)
+	GeoSearchStore(ctx context.Context, destination, key string, opt types.GeoSearchOptions, storeDist bool) (int64, error)
+
+	// Script operations
+	ScriptEval(ctx context.Context, script string, keys, args []string, readOnly bool) (interface{}, error)
+	ScriptEvalSha(ctx context.Context, sha string, keys, args []string, readOnly bool) (interface{}, error)
+	ScriptLoad(ctx context.Context, script string) (string, error)
+	ScriptExists(ctx context.Context, shas []string) ([]bool, error)
+	ScriptFlush(ctx context.Context, mode string) (string, error)
+
+	// Function operations
+	FunctionLoad(ctx context.Context, code string, replace bool) (string, error)
+	FunctionList(ctx context.Context, libraryName string, withCode bool) ([]types.FunctionLibrary, error)
+	FunctionDelete(ctx context.Context, libraryName string) (string, error)
+	FunctionDump(ctx context.Context) (string, error)
+	FunctionRestore(ctx context.Context, payload, policy string) (string, error)
+	FunctionCall(ctx context.Context, function string, keys, args []string, readOnly bool) (interface{}, error)
+
+	// Pipeline operations
+	Pipeline(ctx context.Context, commands [][]string) ([]types.PipelineResult, error)
 }
 
 // RedisConnectionConfig holds the configuration for Redis connection
 type RedisConnectionConfig struct {
 	Addr     string
+	Username string
 	Password string
 	DB       int
-}
\ No newline at end of file
+	TLS      bool
+}
+
+// ZRangeOptions holds the modifiers of the unified ZRANGE command.
+// Start and Stop are ranks by default, scores with ByScore and lexical bounds with ByLex.
+// Score and lexical bounds are given as min and max even with Rev, the command is sent with max first.
+type ZRangeOptions struct {
+	Start   interface{}
+	Stop    interface{}
+	ByScore bool
+	ByLex   bool
+	Rev     bool
+	Offset  int64
+	Count   int64
+}
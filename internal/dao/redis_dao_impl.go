@@ -2,56 +2,200 @@ package dao
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	redis "github.com/go-redis/redis/v8"
 
+	"github.com/ct-zh/go-redis-proxy/internal/config"
 	"github.com/ct-zh/go-redis-proxy/pkg/types"
 )
 
-// RedisDAOImpl implements the RedisDAO interface using go-redis client
+// versionCacheTTL is how long a server version is trusted, so that an upgrade or a
+// failover to a node running another version is noticed without a restart
+const versionCacheTTL = time.Minute
+
+// RedisDAOImpl implements the RedisDAO interface using go-redis client.
+// It is shared by all requests and holds no request state, each Connect
+// returns a RedisConnImpl owned by the caller.
 type RedisDAOImpl struct {
-	client *redis.Client
+	// targets provides the shared clients of named targets
+	targets *TargetManager
+	// tlsConfig and tlsEnabled apply to connections made from addr, clientName to all of them
+	tlsConfig  *tls.Config
+	tlsEnabled bool
+	clientName string
+	// timeouts and retry apply to connections made from addr
+	timeouts config.TimeoutConfig
+	retry    config.RetryConfig
+
+	// versions caches the server version reported by each address as a cachedVersion
+	versions sync.Map
+}
+
+// cachedVersion is a server version and when it was read
+type cachedVersion struct {
+	version string
+	readAt  time.Time
+}
+
+// RedisConnImpl implements the RedisConn interface for the connection of one request
+type RedisConnImpl struct {
+	dao    *RedisDAOImpl
+	client redis.UniversalClient
+
+	// shared marks a target client, which is owned by targets and survives Close
+	shared bool
+	// versionKey identifies the current server in the versions cache
+	versionKey string
+	// keyGroup names the hash slot or shard of a key, nil when all keys live on one node
+	keyGroup func(key string) string
+	// ticket is the admission of the request by the circuit breaker, completed by Close
+	ticket breakerTicket
+	// ctx is the context of the request, a probe whose request gave up does not pass
+	ctx context.Context
 }
 
 // NewRedisDAO creates a new instance of RedisDAOImpl
-func NewRedisDAO() *RedisDAOImpl {
-	return &RedisDAOImpl{}
+func NewRedisDAO(targets *TargetManager, cfg config.RedisConfig) (*RedisDAOImpl, error) {
+	tlsConfig, err := newTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	return &RedisDAOImpl{
+		targets:    targets,
+		tlsConfig:  tlsConfig,
+		tlsEnabled: cfg.TLS.Enabled,
+		clientName: cfg.ClientName,
+		timeouts:   cfg.Timeouts,
+		retry:      cfg.Retry,
+	}, nil
 }
 
-// Connect establishes a connection to Redis
-func (r *RedisDAOImpl) Connect(config types.RedisRequest) error {
-	r.client = redis.NewClient(&redis.Options{
-		Addr:     config.Addr,
-		Password: config.Password,
-		DB:       config.DB,
-	})
-	
-	// Test the connection
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	
-	return r.client.Ping(ctx).Err()
+// Connect establishes a connection to Redis, the initial PING is bound by ctx
+func (r *RedisDAOImpl) Connect(ctx context.Context, config types.RedisRequest) (RedisConn, error) {
+	return r.connect(ctx, config, false)
 }
 
-// Close closes the Redis connection
-func (r *RedisDAOImpl) Close() error {
-	if r.client != nil {
+// ConnectRead establishes a connection for read-only operations, which may be
+// served by a replica when the target enables replica reads and the request
+// does not ask for strong consistency
+func (r *RedisDAOImpl) ConnectRead(ctx context.Context, config types.RedisRequest) (RedisConn, error) {
+	return r.connect(ctx, config, true)
+}
+
+func (r *RedisDAOImpl) connect(ctx context.Context, config types.RedisRequest, read bool) (RedisConn, error) {
+	if config.Consistency == types.ConsistencyStrong {
+		read = false
+	}
+
+	conn := &RedisConnImpl{dao: r, ctx: ctx}
+	if config.Target != "" {
+		client, err := r.targets.Client(config.Target, read)
+		if err != nil {
+			return nil, err
+		}
+		ticket, err := r.targets.Allow(config.Target)
+		if err != nil {
+			return nil, err
+		}
+		conn.ticket = ticket
+		conn.client = client
+		conn.shared = true
+		conn.versionKey = "target:" + config.Target
+		conn.keyGroup = r.targets.KeyGroup(config.Target)
+	} else {
+		hook, ticket, err := r.targets.AllowAddr(config.Addr)
+		if err != nil {
+			return nil, err
+		}
+		conn.ticket = ticket
+		options := &redis.Options{
+			Addr:         config.Addr,
+			Username:     config.Username,
+			Password:     config.Password,
+			DB:           config.DB,
+			OnConnect:    clientNameHook(r.clientName),
+			DialTimeout:  msDuration(r.timeouts.DialMS),
+			ReadTimeout:  msDuration(r.timeouts.ReadMS),
+			WriteTimeout: msDuration(r.timeouts.WriteMS),
+			PoolTimeout:  msDuration(r.timeouts.PoolMS),
+			MaxRetries:   -1,
+		}
+		if config.TLS || r.tlsEnabled {
+			options.TLSConfig = r.tlsConfig
+		}
+		client := redis.NewClient(options)
+		if retry := newRetryHook(client, r.retry); retry != nil {
+			client.AddHook(retry)
+		}
+		if hook != nil {
+			client.AddHook(hook)
+		}
+		conn.client = client
+		conn.versionKey = config.Addr
+	}
+
+	// Test the connection, dial and read timeouts apply when ctx has no deadline
+	if err := conn.client.Ping(ctx).Err(); err != nil {
+		conn.ticket.done(false)
+		if !conn.shared {
+			_ = conn.client.Close()
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Close closes the Redis connection and reports the request to the circuit breaker,
+// target clients stay open for later requests
+func (r *RedisConnImpl) Close() error {
+	r.ticket.done(r.ctx.Err() == nil)
+	if r.client != nil && !r.shared {
 		return r.client.Close()
 	}
 	return nil
 }
 
 // Ping tests the Redis connection
-func (r *RedisDAOImpl) Ping(ctx context.Context) error {
+func (r *RedisConnImpl) Ping(ctx context.Context) error {
 	return r.client.Ping(ctx).Err()
 }
 
+// ServerVersion returns the version reported by INFO server, cached per address or target for versionCacheTTL
+func (r *RedisConnImpl) ServerVersion(ctx context.Context) (string, error) {
+	addr := r.versionKey
+	if cached, ok := r.dao.versions.Load(addr); ok {
+		if entry := cached.(cachedVersion); time.Since(entry.readAt) < versionCacheTTL {
+			return entry.version, nil
+		}
+	}
+
+	info, err := r.client.Info(ctx, "server").Result()
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(info, "\r\n") {
+		if strings.HasPrefix(line, "redis_version:") {
+			version := strings.TrimPrefix(line, "redis_version:")
+			r.dao.versions.Store(addr, cachedVersion{version: version, readAt: time.Now()})
+			return version, nil
+		}
+	}
+	return "", fmt.Errorf("redis_version not found in INFO server reply")
+}
+
 // String operations
 
 // StringGet retrieves a string value from Redis
-func (r *RedisDAOImpl) StringGet(ctx context.Context, key string) (interface{}, error) {
+func (r *RedisConnImpl) StringGet(ctx context.Context, key string) (interface{}, error) {
 	result := r.client.Get(ctx, key)
 	if result.Err() == redis.Nil {
 		return nil, nil // Key does not exist
@@ -62,20 +206,47 @@ func (r *RedisDAOImpl) StringGet(ctx context.Context, key string) (interface{},
 	return result.Val(), nil
 }
 
+// StringMGet retrieves the values of multiple keys, nil for missing keys.
+// On cluster and sharded targets keys are fetched per slot or node and returned in input order.
+func (r *RedisConnImpl) StringMGet(ctx context.Context, keys []string) ([]interface{}, error) {
+	if r.Colocated(keys...) {
+		result := r.client.MGet(ctx, keys...)
+		return result.Val(), result.Err()
+	}
+
+	groups := r.keyGroups(keys)
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(groups))
+	for i, group := range groupKeys(keys, groups) {
+		cmds[i] = pipe.MGet(ctx, group...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(keys))
+	for i, group := range groups {
+		for j, index := range group {
+			values[index] = cmds[i].Val()[j]
+		}
+	}
+	return values, nil
+}
+
 // StringSet sets a string value in Redis with optional TTL
-func (r *RedisDAOImpl) StringSet(ctx context.Context, key string, value interface{}, ttl time.Duration) (string, error) {
+func (r *RedisConnImpl) StringSet(ctx context.Context, key string, value interface{}, ttl time.Duration) (string, error) {
 	result := r.client.Set(ctx, key, value, ttl)
 	return result.Val(), result.Err()
 }
 
 // StringDel deletes a key from Redis
-func (r *RedisDAOImpl) StringDel(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) StringDel(ctx context.Context, key string) (int64, error) {
 	result := r.client.Del(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // StringExists checks if a key exists in Redis
-func (r *RedisDAOImpl) StringExists(ctx context.Context, key string) (bool, error) {
+func (r *RedisConnImpl) StringExists(ctx context.Context, key string) (bool, error) {
 	result := r.client.Exists(ctx, key)
 	if result.Err() != nil {
 		return false, result.Err()
@@ -84,19 +255,19 @@ func (r *RedisDAOImpl) StringExists(ctx context.Context, key string) (bool, erro
 }
 
 // StringIncr increments the integer value of a key by 1
-func (r *RedisDAOImpl) StringIncr(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) StringIncr(ctx context.Context, key string) (int64, error) {
 	result := r.client.Incr(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // StringDecr decrements the integer value of a key by 1
-func (r *RedisDAOImpl) StringDecr(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) StringDecr(ctx context.Context, key string) (int64, error) {
 	result := r.client.Decr(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // StringExpire sets TTL for a key
-func (r *RedisDAOImpl) StringExpire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+func (r *RedisConnImpl) StringExpire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
 	result := r.client.Expire(ctx, key, ttl)
 	return result.Val(), result.Err()
 }
@@ -104,7 +275,7 @@ func (r *RedisDAOImpl) StringExpire(ctx context.Context, key string, ttl time.Du
 // List operations
 
 // ListLPush pushes values to the left of a list
-func (r *RedisDAOImpl) ListLPush(ctx context.Context, key string, values []string) (int64, error) {
+func (r *RedisConnImpl) ListLPush(ctx context.Context, key string, values []string) (int64, error) {
 	if len(values) == 0 {
 		return 0, nil
 	}
@@ -120,7 +291,7 @@ func (r *RedisDAOImpl) ListLPush(ctx context.Context, key string, values []strin
 }
 
 // ListRPush pushes values to the right of a list
-func (r *RedisDAOImpl) ListRPush(ctx context.Context, key string, values []string) (int64, error) {
+func (r *RedisConnImpl) ListRPush(ctx context.Context, key string, values []string) (int64, error) {
 	if len(values) == 0 {
 		return 0, nil
 	}
@@ -136,7 +307,7 @@ func (r *RedisDAOImpl) ListRPush(ctx context.Context, key string, values []strin
 }
 
 // ListLPop pops a value from the left of a list
-func (r *RedisDAOImpl) ListLPop(ctx context.Context, key string) (interface{}, error) {
+func (r *RedisConnImpl) ListLPop(ctx context.Context, key string) (interface{}, error) {
 	result := r.client.LPop(ctx, key)
 	if result.Err() == redis.Nil {
 		return nil, nil // List is empty or key does not exist
@@ -148,7 +319,7 @@ func (r *RedisDAOImpl) ListLPop(ctx context.Context, key string) (interface{}, e
 }
 
 // ListRPop pops a value from the right of a list
-func (r *RedisDAOImpl) ListRPop(ctx context.Context, key string) (interface{}, error) {
+func (r *RedisConnImpl) ListRPop(ctx context.Context, key string) (interface{}, error) {
 	result := r.client.RPop(ctx, key)
 	if result.Err() == redis.Nil {
 		return nil, nil // List is empty or key does not exist
@@ -160,13 +331,13 @@ func (r *RedisDAOImpl) ListRPop(ctx context.Context, key string) (interface{}, e
 }
 
 // ListLRem removes elements from a list
-func (r *RedisDAOImpl) ListLRem(ctx context.Context, key string, count int64, value string) (int64, error) {
+func (r *RedisConnImpl) ListLRem(ctx context.Context, key string, count int64, value string) (int64, error) {
 	result := r.client.LRem(ctx, key, count, value)
 	return result.Val(), result.Err()
 }
 
 // ListLIndex gets an element from a list by index
-func (r *RedisDAOImpl) ListLIndex(ctx context.Context, key string, index int64) (interface{}, error) {
+func (r *RedisConnImpl) ListLIndex(ctx context.Context, key string, index int64) (interface{}, error) {
 	result := r.client.LIndex(ctx, key, index)
 	if result.Err() == redis.Nil {
 		return nil, nil // Index out of range or key does not exist
@@ -178,19 +349,19 @@ func (r *RedisDAOImpl) ListLIndex(ctx context.Context, key string, index int64)
 }
 
 // ListLRange gets a range of elements from a list
-func (r *RedisDAOImpl) ListLRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+func (r *RedisConnImpl) ListLRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
 	result := r.client.LRange(ctx, key, start, stop)
 	return result.Val(), result.Err()
 }
 
 // ListLLen gets the length of a list
-func (r *RedisDAOImpl) ListLLen(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) ListLLen(ctx context.Context, key string) (int64, error) {
 	result := r.client.LLen(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // ListLTrim trims a list to a specified range
-func (r *RedisDAOImpl) ListLTrim(ctx context.Context, key string, start, stop int64) (string, error) {
+func (r *RedisConnImpl) ListLTrim(ctx context.Context, key string, start, stop int64) (string, error) {
 	result := r.client.LTrim(ctx, key, start, stop)
 	return result.Val(), result.Err()
 }
@@ -198,7 +369,7 @@ func (r *RedisDAOImpl) ListLTrim(ctx context.Context, key string, start, stop in
 // Set operations
 
 // SetSAdd adds members to a set
-func (r *RedisDAOImpl) SetSAdd(ctx context.Context, key string, members []string) (int64, error) {
+func (r *RedisConnImpl) SetSAdd(ctx context.Context, key string, members []string) (int64, error) {
 	// convert []string to []interface{}
 	interfaces := make([]interface{}, len(members))
 	for i, v := range members {
@@ -209,7 +380,7 @@ func (r *RedisDAOImpl) SetSAdd(ctx context.Context, key string, members []string
 }
 
 // SetSRem removes members from a set
-func (r *RedisDAOImpl) SetSRem(ctx context.Context, key string, members []string) (int64, error) {
+func (r *RedisConnImpl) SetSRem(ctx context.Context, key string, members []string) (int64, error) {
 	// convert []string to []interface{}
 	interfaces := make([]interface{}, len(members))
 	for i, v := range members {
@@ -220,44 +391,223 @@ func (r *RedisDAOImpl) SetSRem(ctx context.Context, key string, members []string
 }
 
 // SetSIsMember checks if a member exists in a set
-func (r *RedisDAOImpl) SetSIsMember(ctx context.Context, key string, member string) (bool, error) {
+func (r *RedisConnImpl) SetSIsMember(ctx context.Context, key string, member string) (bool, error) {
 	result := r.client.SIsMember(ctx, key, member)
 	return result.Val(), result.Err()
 }
 
 // SetSMembers returns all members of a set
-func (r *RedisDAOImpl) SetSMembers(ctx context.Context, key string) ([]string, error) {
+func (r *RedisConnImpl) SetSMembers(ctx context.Context, key string) ([]string, error) {
 	result := r.client.SMembers(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // SetSCard returns the number of members in a set
-func (r *RedisDAOImpl) SetSCard(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) SetSCard(ctx context.Context, key string) (int64, error) {
 	result := r.client.SCard(ctx, key)
 	return result.Val(), result.Err()
 }
 
-// ZSet operations
+// SetSInter returns the intersection of multiple sets
+func (r *RedisConnImpl) SetSInter(ctx context.Context, keys []string) ([]string, error) {
+	if !r.Colocated(keys...) {
+		sets, err := r.setsByGroup(ctx, "sinter", keys)
+		if err != nil {
+			return nil, err
+		}
+		return intersectMembers(sets), nil
+	}
+	result := r.client.SInter(ctx, keys...)
+	return result.Val(), result.Err()
+}
+
+// SetSInterStore stores the intersection of multiple sets in destination
+func (r *RedisConnImpl) SetSInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result := r.client.SInterStore(ctx, destination, keys...)
+	return result.Val(), result.Err()
+}
+
+// SetSInterCard returns the cardinality of the intersection of multiple sets,
+// stopping early once limit is reached (0 means no limit)
+func (r *RedisConnImpl) SetSInterCard(ctx context.Context, keys []string, limit int64) (int64, error) {
+	if !r.Colocated(keys...) {
+		members, err := r.SetSInter(ctx, keys)
+		if err != nil {
+			return 0, err
+		}
+		count := int64(len(members))
+		if limit > 0 && count > limit {
+			count = limit
+		}
+		return count, nil
+	}
+
+	// SINTERCARD numkeys key [key ...] [LIMIT limit]
+	args := make([]interface{}, 0, len(keys)+4)
+	args = append(args, "sintercard", len(keys))
+	for _, key := range keys {
+		args = append(args, key)
+	}
+	if limit > 0 {
+		args = append(args, "limit", limit)
+	}
+	result := redis.NewIntCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
 
-// ZSetZAdd adds members with scores to a sorted set
-func (r *RedisDAOImpl) ZSetZAdd(ctx context.Context, key string, members map[string]float64) (int64, error) {
-	// convert map to []*redis.Z
-	zs := make([]*redis.Z, 0, len(members))
-	for member, score := range members {
-		zs = append(zs, &redis.Z{Score: score, Member: member})
+// SetSUnion returns the union of multiple sets
+func (r *RedisConnImpl) SetSUnion(ctx context.Context, keys []string) ([]string, error) {
+	if !r.Colocated(keys...) {
+		sets, err := r.setsByGroup(ctx, "sunion", keys)
+		if err != nil {
+			return nil, err
+		}
+		return unionMembers(sets), nil
 	}
-	result := r.client.ZAdd(ctx, key, zs...)
+	result := r.client.SUnion(ctx, keys...)
+	return result.Val(), result.Err()
+}
+
+// SetSUnionStore stores the union of multiple sets in destination
+func (r *RedisConnImpl) SetSUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result := r.client.SUnionStore(ctx, destination, keys...)
+	return result.Val(), result.Err()
+}
+
+// SetSDiff returns the difference between the first set and all successive sets
+func (r *RedisConnImpl) SetSDiff(ctx context.Context, keys []string) ([]string, error) {
+	if !r.Colocated(keys...) {
+		// The difference is the first set minus the union of the others
+		first, err := r.client.SMembers(ctx, keys[0]).Result()
+		if err != nil {
+			return nil, err
+		}
+		others, err := r.setsByGroup(ctx, "sunion", keys[1:])
+		if err != nil {
+			return nil, err
+		}
+		excluded := make(map[string]bool)
+		for _, member := range unionMembers(others) {
+			excluded[member] = true
+		}
+		members := []string{}
+		for _, member := range first {
+			if !excluded[member] {
+				members = append(members, member)
+			}
+		}
+		return members, nil
+	}
+	result := r.client.SDiff(ctx, keys...)
+	return result.Val(), result.Err()
+}
+
+// SetSDiffStore stores the difference of multiple sets in destination
+func (r *RedisConnImpl) SetSDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result := r.client.SDiffStore(ctx, destination, keys...)
+	return result.Val(), result.Err()
+}
+
+// SetSMove moves a member from the source set to the destination set
+func (r *RedisConnImpl) SetSMove(ctx context.Context, source, destination string, member string) (bool, error) {
+	result := r.client.SMove(ctx, source, destination, member)
+	return result.Val(), result.Err()
+}
+
+// SetSPop removes and returns random members from a set.
+// A count of 0 pops a single member, as SPOP without the count argument does.
+func (r *RedisConnImpl) SetSPop(ctx context.Context, key string, count int64) ([]string, error) {
+	if count > 0 {
+		result := r.client.SPopN(ctx, key, count)
+		return result.Val(), result.Err()
+	}
+
+	result := r.client.SPop(ctx, key)
+	if result.Err() == redis.Nil {
+		return []string{}, nil // Set is empty or key does not exist
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return []string{result.Val()}, nil
+}
+
+// SetSRandMember returns random members from a set without removing them.
+// A count of 0 returns a single member; a negative count may return duplicates.
+func (r *RedisConnImpl) SetSRandMember(ctx context.Context, key string, count int64) ([]string, error) {
+	if count != 0 {
+		result := r.client.SRandMemberN(ctx, key, count)
+		return result.Val(), result.Err()
+	}
+
+	result := r.client.SRandMember(ctx, key)
+	if result.Err() == redis.Nil {
+		return []string{}, nil // Set is empty or key does not exist
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return []string{result.Val()}, nil
+}
+
+// SetSMIsMember checks whether each of the given members exists in a set
+func (r *RedisConnImpl) SetSMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
+	// convert []string to []interface{}
+	interfaces := make([]interface{}, len(members))
+	for i, v := range members {
+		interfaces[i] = v
+	}
+	result := r.client.SMIsMember(ctx, key, interfaces...)
+	return result.Val(), result.Err()
+}
+
+// ZSet operations
+
+// ZSetZAdd adds members with scores to a sorted set, honouring the NX/XX/GT/LT/CH flags.
+// Members are sent in the given order.
+func (r *RedisConnImpl) ZSetZAdd(ctx context.Context, key string, members []types.ZSetMember, flags types.ZAddFlags) (int64, error) {
+	result := r.client.ZAddArgs(ctx, key, zAddArgs(members, flags))
 	return result.Val(), result.Err()
 }
 
+// ZSetZAddIncr increments the score of a single member like ZINCRBY, honouring the ZADD flags.
+// It returns nil when the operation was aborted because of the NX/XX/GT/LT conditions.
+func (r *RedisConnImpl) ZSetZAddIncr(ctx context.Context, key string, member types.ZSetMember, flags types.ZAddFlags) (interface{}, error) {
+	result := r.client.ZAddArgsIncr(ctx, key, zAddArgs([]types.ZSetMember{member}, flags))
+	if result.Err() == redis.Nil {
+		return nil, nil
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return result.Val(), nil
+}
+
+// zAddArgs converts members and flags into go-redis ZADD arguments
+func zAddArgs(members []types.ZSetMember, flags types.ZAddFlags) redis.ZAddArgs {
+	zs := make([]redis.Z, len(members))
+	for i, m := range members {
+		zs[i] = redis.Z{Score: m.Score, Member: m.Member}
+	}
+	return redis.ZAddArgs{
+		NX:      flags.NX,
+		XX:      flags.XX,
+		GT:      flags.GT,
+		LT:      flags.LT,
+		Ch:      flags.CH,
+		Members: zs,
+	}
+}
+
 // ZSetZIncrBy increments the score of a member in a sorted set
-func (r *RedisDAOImpl) ZSetZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+func (r *RedisConnImpl) ZSetZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
 	result := r.client.ZIncrBy(ctx, key, increment, member)
 	return result.Val(), result.Err()
 }
 
 // ZSetZScore gets the score of a member in a sorted set
-func (r *RedisDAOImpl) ZSetZScore(ctx context.Context, key string, member string) (interface{}, error) {
+func (r *RedisConnImpl) ZSetZScore(ctx context.Context, key string, member string) (interface{}, error) {
 	result := r.client.ZScore(ctx, key, member)
 	if result.Err() == redis.Nil {
 		return nil, nil
@@ -269,19 +619,19 @@ func (r *RedisDAOImpl) ZSetZScore(ctx context.Context, key string, member string
 }
 
 // ZSetZCard gets the number of members in a sorted set
-func (r *RedisDAOImpl) ZSetZCard(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) ZSetZCard(ctx context.Context, key string) (int64, error) {
 	result := r.client.ZCard(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // ZSetZCount counts members in a sorted set within a score range
-func (r *RedisDAOImpl) ZSetZCount(ctx context.Context, key string, min, max float64) (int64, error) {
+func (r *RedisConnImpl) ZSetZCount(ctx context.Context, key string, min, max float64) (int64, error) {
 	result := r.client.ZCount(ctx, key, fmt.Sprintf("%f", min), fmt.Sprintf("%f", max))
 	return result.Val(), result.Err()
 }
 
 // ZSetZRank gets the rank of a member in a sorted set (ascending order)
-func (r *RedisDAOImpl) ZSetZRank(ctx context.Context, key string, member string) (interface{}, error) {
+func (r *RedisConnImpl) ZSetZRank(ctx context.Context, key string, member string) (interface{}, error) {
 	result := r.client.ZRank(ctx, key, member)
 	if result.Err() == redis.Nil {
 		return nil, nil
@@ -293,7 +643,7 @@ func (r *RedisDAOImpl) ZSetZRank(ctx context.Context, key string, member string)
 }
 
 // ZSetZRevRank gets the rank of a member in a sorted set (descending order)
-func (r *RedisDAOImpl) ZSetZRevRank(ctx context.Context, key string, member string) (interface{}, error) {
+func (r *RedisConnImpl) ZSetZRevRank(ctx context.Context, key string, member string) (interface{}, error) {
 	result := r.client.ZRevRank(ctx, key, member)
 	if result.Err() == redis.Nil {
 		return nil, nil
@@ -305,7 +655,7 @@ func (r *RedisDAOImpl) ZSetZRevRank(ctx context.Context, key string, member stri
 }
 
 // ZSetZRange gets members from a sorted set by rank range (ascending order)
-func (r *RedisDAOImpl) ZSetZRange(ctx context.Context, key string, start, stop int64, withScores bool) ([]interface{}, error) {
+func (r *RedisConnImpl) ZSetZRange(ctx context.Context, key string, start, stop int64, withScores bool) ([]interface{}, error) {
 	var result []interface{}
 	var err error
 	
@@ -331,7 +681,7 @@ func (r *RedisDAOImpl) ZSetZRange(ctx context.Context, key string, start, stop i
 }
 
 // ZSetZRevRange gets members from a sorted set by rank range (descending order)
-func (r *RedisDAOImpl) ZSetZRevRange(ctx context.Context, key string, start, stop int64, withScores bool) ([]interface{}, error) {
+func (r *RedisConnImpl) ZSetZRevRange(ctx context.Context, key string, start, stop int64, withScores bool) ([]interface{}, error) {
 	var result []interface{}
 	var err error
 	
@@ -357,7 +707,7 @@ func (r *RedisDAOImpl) ZSetZRevRange(ctx context.Context, key string, start, sto
 }
 
 // ZSetZRangeByScore gets members from a sorted set by score range (ascending order)
-func (r *RedisDAOImpl) ZSetZRangeByScore(ctx context.Context, key string, min, max string, withScores bool, offset, count int64) ([]interface{}, error) {
+func (r *RedisConnImpl) ZSetZRangeByScore(ctx context.Context, key string, min, max string, withScores bool, offset, count int64) ([]interface{}, error) {
 	var result []interface{}
 	var err error
 	
@@ -393,7 +743,7 @@ func (r *RedisDAOImpl) ZSetZRangeByScore(ctx context.Context, key string, min, m
 }
 
 // ZSetZRevRangeByScore gets members from a sorted set by score range (descending order)
-func (r *RedisDAOImpl) ZSetZRevRangeByScore(ctx context.Context, key string, max, min string, withScores bool, offset, count int64) ([]interface{}, error) {
+func (r *RedisConnImpl) ZSetZRevRangeByScore(ctx context.Context, key string, max, min string, withScores bool, offset, count int64) ([]interface{}, error) {
 	var result []interface{}
 	var err error
 	
@@ -429,7 +779,7 @@ func (r *RedisDAOImpl) ZSetZRevRangeByScore(ctx context.Context, key string, max
 }
 
 // ZSetZRem removes members from a sorted set
-func (r *RedisDAOImpl) ZSetZRem(ctx context.Context, key string, members []string) (int64, error) {
+func (r *RedisConnImpl) ZSetZRem(ctx context.Context, key string, members []string) (int64, error) {
 	// convert []string to []interface{}
 	interfaces := make([]interface{}, len(members))
 	for i, v := range members {
@@ -440,21 +790,275 @@ func (r *RedisDAOImpl) ZSetZRem(ctx context.Context, key string, members []strin
 }
 
 // ZSetZRemRangeByRank removes members from a sorted set by rank range
-func (r *RedisDAOImpl) ZSetZRemRangeByRank(ctx context.Context, key string, start, stop int64) (int64, error) {
+func (r *RedisConnImpl) ZSetZRemRangeByRank(ctx context.Context, key string, start, stop int64) (int64, error) {
 	result := r.client.ZRemRangeByRank(ctx, key, start, stop)
 	return result.Val(), result.Err()
 }
 
 // ZSetZRemRangeByScore removes members from a sorted set by score range
-func (r *RedisDAOImpl) ZSetZRemRangeByScore(ctx context.Context, key string, min, max string) (int64, error) {
+func (r *RedisConnImpl) ZSetZRemRangeByScore(ctx context.Context, key string, min, max string) (int64, error) {
 	result := r.client.ZRemRangeByScore(ctx, key, min, max)
 	return result.Val(), result.Err()
 }
 
+// ZSetZRangeArgs gets members from a sorted set using the unified ZRANGE command
+// with the BYSCORE/BYLEX/REV/LIMIT modifiers
+func (r *RedisConnImpl) ZSetZRangeArgs(ctx context.Context, key string, opt ZRangeOptions, withScores bool) ([]interface{}, error) {
+	var result []interface{}
+
+	args := redis.ZRangeArgs{
+		Key:     key,
+		Start:   opt.Start,
+		Stop:    opt.Stop,
+		ByScore: opt.ByScore,
+		ByLex:   opt.ByLex,
+		Rev:     opt.Rev,
+	}
+	if opt.Count > 0 {
+		args.Offset = opt.Offset
+		args.Count = opt.Count
+	}
+
+	if withScores {
+		zResult := r.client.ZRangeArgsWithScores(ctx, args)
+		if zResult.Err() != nil {
+			return nil, zResult.Err()
+		}
+		for _, z := range zResult.Val() {
+			result = append(result, z.Member, z.Score)
+		}
+	} else {
+		strResult := r.client.ZRangeArgs(ctx, args)
+		if strResult.Err() != nil {
+			return nil, strResult.Err()
+		}
+		for _, member := range strResult.Val() {
+			result = append(result, member)
+		}
+	}
+
+	return result, nil
+}
+
+// ZSetZRangeByLex gets members from a sorted set by lexicographical range
+func (r *RedisConnImpl) ZSetZRangeByLex(ctx context.Context, key string, min, max string, offset, count int64) ([]string, error) {
+	opt := &redis.ZRangeBy{
+		Min: min,
+		Max: max,
+	}
+
+	if count > 0 {
+		opt.Offset = offset
+		opt.Count = count
+	}
+
+	result := r.client.ZRangeByLex(ctx, key, opt)
+	return result.Val(), result.Err()
+}
+
+// ZSetZLexCount counts members in a sorted set within a lexicographical range
+func (r *RedisConnImpl) ZSetZLexCount(ctx context.Context, key string, min, max string) (int64, error) {
+	result := r.client.ZLexCount(ctx, key, min, max)
+	return result.Val(), result.Err()
+}
+
+// ZSetZRemRangeByLex removes members from a sorted set by lexicographical range
+func (r *RedisConnImpl) ZSetZRemRangeByLex(ctx context.Context, key string, min, max string) (int64, error) {
+	result := r.client.ZRemRangeByLex(ctx, key, min, max)
+	return result.Val(), result.Err()
+}
+
+// ZSetZPopMin removes and returns the members with the lowest scores
+func (r *RedisConnImpl) ZSetZPopMin(ctx context.Context, key string, count int64) ([]types.ZSetMember, error) {
+	var result *redis.ZSliceCmd
+	if count > 0 {
+		result = r.client.ZPopMin(ctx, key, count)
+	} else {
+		result = r.client.ZPopMin(ctx, key)
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return toZSetMembers(result.Val()), nil
+}
+
+// ZSetZPopMax removes and returns the members with the highest scores
+func (r *RedisConnImpl) ZSetZPopMax(ctx context.Context, key string, count int64) ([]types.ZSetMember, error) {
+	var result *redis.ZSliceCmd
+	if count > 0 {
+		result = r.client.ZPopMax(ctx, key, count)
+	} else {
+		result = r.client.ZPopMax(ctx, key)
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return toZSetMembers(result.Val()), nil
+}
+
+// ZSetBZPopMin removes and returns the member with the lowest score from the first
+// non-empty sorted set, blocking until one is available or the timeout expires.
+// It returns a nil member on timeout.
+func (r *RedisConnImpl) ZSetBZPopMin(ctx context.Context, keys []string, timeout time.Duration) (string, *types.ZSetMember, error) {
+	result := r.client.BZPopMin(ctx, timeout, keys...)
+	if result.Err() == redis.Nil {
+		return "", nil, nil // Timed out
+	}
+	if result.Err() != nil {
+		return "", nil, result.Err()
+	}
+	z := result.Val()
+	return z.Key, &types.ZSetMember{Member: fmt.Sprint(z.Member), Score: z.Score}, nil
+}
+
+// ZSetZMScore gets the scores of multiple members, with nil for members that do not exist
+func (r *RedisConnImpl) ZSetZMScore(ctx context.Context, key string, members []string) ([]interface{}, error) {
+	// ZMScore of go-redis turns missing members into 0, so read the raw reply instead
+	args := make([]interface{}, 0, len(members)+2)
+	args = append(args, "zmscore", key)
+	for _, member := range members {
+		args = append(args, member)
+	}
+	result := redis.NewSliceCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	scores := make([]interface{}, len(result.Val()))
+	for i, v := range result.Val() {
+		if v == nil {
+			continue
+		}
+		score, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return nil, err
+		}
+		scores[i] = score
+	}
+	return scores, nil
+}
+
+// ZSetZRandMember returns random members from a sorted set without removing them.
+// A count of 0 returns a single member; a negative count may return duplicates.
+func (r *RedisConnImpl) ZSetZRandMember(ctx context.Context, key string, count int64, withScores bool) ([]types.ZSetMember, error) {
+	if count == 0 {
+		count = 1
+	}
+	result := r.client.ZRandMember(ctx, key, int(count), withScores)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	if !withScores {
+		return namesToZSetMembers(result.Val()), nil
+	}
+
+	// WITHSCORES replies with a flat member, score, member, score... list
+	values := result.Val()
+	members := make([]types.ZSetMember, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseFloat(values[i+1], 64)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, types.ZSetMember{Member: values[i], Score: score})
+	}
+	return members, nil
+}
+
+// ZSetZUnion returns the union of multiple sorted sets
+func (r *RedisConnImpl) ZSetZUnion(ctx context.Context, keys []string, weights []float64, aggregate string, withScores bool) ([]types.ZSetMember, error) {
+	store := redis.ZStore{Keys: keys, Weights: weights, Aggregate: aggregate}
+	if withScores {
+		result := r.client.ZUnionWithScores(ctx, store)
+		if result.Err() != nil {
+			return nil, result.Err()
+		}
+		return toZSetMembers(result.Val()), nil
+	}
+
+	result := r.client.ZUnion(ctx, store)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return namesToZSetMembers(result.Val()), nil
+}
+
+// ZSetZUnionStore stores the union of multiple sorted sets in destination
+func (r *RedisConnImpl) ZSetZUnionStore(ctx context.Context, destination string, keys []string, weights []float64, aggregate string) (int64, error) {
+	result := r.client.ZUnionStore(ctx, destination, &redis.ZStore{Keys: keys, Weights: weights, Aggregate: aggregate})
+	return result.Val(), result.Err()
+}
+
+// ZSetZInter returns the intersection of multiple sorted sets
+func (r *RedisConnImpl) ZSetZInter(ctx context.Context, keys []string, weights []float64, aggregate string, withScores bool) ([]types.ZSetMember, error) {
+	store := &redis.ZStore{Keys: keys, Weights: weights, Aggregate: aggregate}
+	if withScores {
+		result := r.client.ZInterWithScores(ctx, store)
+		if result.Err() != nil {
+			return nil, result.Err()
+		}
+		return toZSetMembers(result.Val()), nil
+	}
+
+	result := r.client.ZInter(ctx, store)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return namesToZSetMembers(result.Val()), nil
+}
+
+// ZSetZInterStore stores the intersection of multiple sorted sets in destination
+func (r *RedisConnImpl) ZSetZInterStore(ctx context.Context, destination string, keys []string, weights []float64, aggregate string) (int64, error) {
+	result := r.client.ZInterStore(ctx, destination, &redis.ZStore{Keys: keys, Weights: weights, Aggregate: aggregate})
+	return result.Val(), result.Err()
+}
+
+// ZSetZDiff returns the members of the first sorted set that are not in the others
+func (r *RedisConnImpl) ZSetZDiff(ctx context.Context, keys []string, withScores bool) ([]types.ZSetMember, error) {
+	if withScores {
+		result := r.client.ZDiffWithScores(ctx, keys...)
+		if result.Err() != nil {
+			return nil, result.Err()
+		}
+		return toZSetMembers(result.Val()), nil
+	}
+
+	result := r.client.ZDiff(ctx, keys...)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return namesToZSetMembers(result.Val()), nil
+}
+
+// ZSetZDiffStore stores the difference of multiple sorted sets in destination
+func (r *RedisConnImpl) ZSetZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result := r.client.ZDiffStore(ctx, destination, keys...)
+	return result.Val(), result.Err()
+}
+
+// toZSetMembers converts go-redis scored members into ZSetMember values
+func toZSetMembers(zs []redis.Z) []types.ZSetMember {
+	members := make([]types.ZSetMember, len(zs))
+	for i, z := range zs {
+		members[i] = types.ZSetMember{Member: fmt.Sprint(z.Member), Score: z.Score}
+	}
+	return members
+}
+
+// namesToZSetMembers wraps plain member names, leaving the scores unset
+func namesToZSetMembers(names []string) []types.ZSetMember {
+	members := make([]types.ZSetMember, len(names))
+	for i, name := range names {
+		members[i] = types.ZSetMember{Member: name}
+	}
+	return members
+}
+
 // Hash operations
 
 // HashHSet sets field-value pairs in a hash
-func (r *RedisDAOImpl) HashHSet(ctx context.Context, key string, fields map[string]string) (int64, error) {
+func (r *RedisConnImpl) HashHSet(ctx context.Context, key string, fields map[string]string) (int64, error) {
 	// convert map to []interface{}
 	values := make([]interface{}, 0, len(fields)*2)
 	for field, value := range fields {
@@ -465,7 +1069,7 @@ func (r *RedisDAOImpl) HashHSet(ctx context.Context, key string, fields map[stri
 }
 
 // HashHGet gets the value of a field in a hash
-func (r *RedisDAOImpl) HashHGet(ctx context.Context, key string, field string) (interface{}, error) {
+func (r *RedisConnImpl) HashHGet(ctx context.Context, key string, field string) (interface{}, error) {
 	result := r.client.HGet(ctx, key, field)
 	if result.Err() == redis.Nil {
 		return nil, nil
@@ -477,49 +1081,656 @@ func (r *RedisDAOImpl) HashHGet(ctx context.Context, key string, field string) (
 }
 
 // HashHMGet gets values of multiple fields in a hash
-func (r *RedisDAOImpl) HashHMGet(ctx context.Context, key string, fields []string) ([]interface{}, error) {
+func (r *RedisConnImpl) HashHMGet(ctx context.Context, key string, fields []string) ([]interface{}, error) {
 	result := r.client.HMGet(ctx, key, fields...)
 	return result.Val(), result.Err()
 }
 
 // HashHGetAll gets all field-value pairs in a hash
-func (r *RedisDAOImpl) HashHGetAll(ctx context.Context, key string) (map[string]string, error) {
+func (r *RedisConnImpl) HashHGetAll(ctx context.Context, key string) (map[string]string, error) {
 	result := r.client.HGetAll(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // HashHDel deletes fields from a hash
-func (r *RedisDAOImpl) HashHDel(ctx context.Context, key string, fields []string) (int64, error) {
+func (r *RedisConnImpl) HashHDel(ctx context.Context, key string, fields []string) (int64, error) {
 	result := r.client.HDel(ctx, key, fields...)
 	return result.Val(), result.Err()
 }
 
 // HashHExists checks if a field exists in a hash
-func (r *RedisDAOImpl) HashHExists(ctx context.Context, key string, field string) (bool, error) {
+func (r *RedisConnImpl) HashHExists(ctx context.Context, key string, field string) (bool, error) {
 	result := r.client.HExists(ctx, key, field)
 	return result.Val(), result.Err()
 }
 
 // HashHLen gets the number of fields in a hash
-func (r *RedisDAOImpl) HashHLen(ctx context.Context, key string) (int64, error) {
+func (r *RedisConnImpl) HashHLen(ctx context.Context, key string) (int64, error) {
 	result := r.client.HLen(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // HashHKeys gets all field names in a hash
-func (r *RedisDAOImpl) HashHKeys(ctx context.Context, key string) ([]string, error) {
+func (r *RedisConnImpl) HashHKeys(ctx context.Context, key string) ([]string, error) {
 	result := r.client.HKeys(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // HashHVals gets all field values in a hash
-func (r *RedisDAOImpl) HashHVals(ctx context.Context, key string) ([]string, error) {
+func (r *RedisConnImpl) HashHVals(ctx context.Context, key string) ([]string, error) {
 	result := r.client.HVals(ctx, key)
 	return result.Val(), result.Err()
 }
 
 // HashHIncrBy increments the value of a field in a hash by an integer
-func (r *RedisDAOImpl) HashHIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error) {
+func (r *RedisConnImpl) HashHIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error) {
 	result := r.client.HIncrBy(ctx, key, field, increment)
 	return result.Val(), result.Err()
-}
\ No newline at end of file
+}
+
+// HashHSetNX sets a field in a hash only if it does not exist yet
+func (r *RedisConnImpl) HashHSetNX(ctx context.Context, key string, field string, value string) (bool, error) {
+	result := r.client.HSetNX(ctx, key, field, value)
+	return result.Val(), result.Err()
+}
+
+// HashHIncrByFloat increments the value of a field in a hash by a float
+func (r *RedisConnImpl) HashHIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error) {
+	result := r.client.HIncrByFloat(ctx, key, field, increment)
+	return result.Val(), result.Err()
+}
+
+// HashHStrLen gets the string length of the value of a field in a hash
+func (r *RedisConnImpl) HashHStrLen(ctx context.Context, key string, field string) (int64, error) {
+	result := redis.NewIntCmd(ctx, "hstrlen", key, field)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// HashHRandField returns random fields from a hash, optionally with their values.
+// A count of 0 returns a single field; a negative count may return duplicates.
+func (r *RedisConnImpl) HashHRandField(ctx context.Context, key string, count int64, withValues bool) ([]types.HashField, error) {
+	if count == 0 {
+		count = 1
+	}
+	result := r.client.HRandField(ctx, key, int(count), withValues)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	values := result.Val()
+	if !withValues {
+		fields := make([]types.HashField, len(values))
+		for i, field := range values {
+			fields[i] = types.HashField{Field: field}
+		}
+		return fields, nil
+	}
+
+	// WITHVALUES replies with a flat field, value, field, value... list
+	fields := make([]types.HashField, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		fields = append(fields, types.HashField{Field: values[i], Value: values[i+1]})
+	}
+	return fields, nil
+}
+
+// HashHExpire sets a TTL on individual hash fields, optionally only when the
+// NX/XX/GT/LT condition holds. It returns one status code per field.
+func (r *RedisConnImpl) HashHExpire(ctx context.Context, key string, ttl time.Duration, condition string, fields []string) ([]int64, error) {
+	// HEXPIRE key seconds [NX | XX | GT | LT] FIELDS numfields field [field ...]
+	args := make([]interface{}, 0, len(fields)+6)
+	args = append(args, "hexpire", key, int64(ttl/time.Second))
+	if condition != "" {
+		args = append(args, condition)
+	}
+	args = append(args, hashFieldsArgs(fields)...)
+	result := redis.NewIntSliceCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// HashHTTL gets the remaining TTL in seconds of individual hash fields
+func (r *RedisConnImpl) HashHTTL(ctx context.Context, key string, fields []string) ([]int64, error) {
+	args := append([]interface{}{"httl", key}, hashFieldsArgs(fields)...)
+	result := redis.NewIntSliceCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// HashHPersist removes the TTL of individual hash fields
+func (r *RedisConnImpl) HashHPersist(ctx context.Context, key string, fields []string) ([]int64, error) {
+	args := append([]interface{}{"hpersist", key}, hashFieldsArgs(fields)...)
+	result := redis.NewIntSliceCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// hashFieldsArgs builds the FIELDS numfields field [field ...] arguments of the hash field expiration commands
+func hashFieldsArgs(fields []string) []interface{} {
+	args := make([]interface{}, 0, len(fields)+2)
+	args = append(args, "fields", len(fields))
+	for _, field := range fields {
+		args = append(args, field)
+	}
+	return args
+}
+
+// BitmapSetBit sets the bit at offset and returns its previous value
+func (r *RedisConnImpl) BitmapSetBit(ctx context.Context, key string, offset int64, value int) (int64, error) {
+	result := r.client.SetBit(ctx, key, offset, value)
+	return result.Val(), result.Err()
+}
+
+// BitmapGetBit gets the bit at offset
+func (r *RedisConnImpl) BitmapGetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	result := r.client.GetBit(ctx, key, offset)
+	return result.Val(), result.Err()
+}
+
+// BitmapBitCount counts the set bits, optionally within a BYTE or BIT range
+func (r *RedisConnImpl) BitmapBitCount(ctx context.Context, key string, start, end *int64, unit string) (int64, error) {
+	args := append([]interface{}{"bitcount", key}, bitRangeArgs(start, end, unit)...)
+	result := redis.NewIntCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// BitmapBitPos finds the first bit set to bit, optionally within a BYTE or BIT range
+func (r *RedisConnImpl) BitmapBitPos(ctx context.Context, key string, bit int64, start, end *int64, unit string) (int64, error) {
+	args := append([]interface{}{"bitpos", key, bit}, bitRangeArgs(start, end, unit)...)
+	result := redis.NewIntCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// BitmapBitOp performs a bitwise operation between keys and stores the result in destination
+func (r *RedisConnImpl) BitmapBitOp(ctx context.Context, operation, destination string, keys []string) (int64, error) {
+	args := make([]interface{}, 0, len(keys)+3)
+	args = append(args, "bitop", operation, destination)
+	for _, key := range keys {
+		args = append(args, key)
+	}
+	result := redis.NewIntCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// BitmapBitField runs BITFIELD, or BITFIELD_RO when readOnly is set.
+// The reply holds one entry per non-OVERFLOW operation; entries are nil when
+// an OVERFLOW FAIL operation was not performed.
+func (r *RedisConnImpl) BitmapBitField(ctx context.Context, key string, readOnly bool, operations []types.BitFieldOperation) ([]interface{}, error) {
+	command := "bitfield"
+	if readOnly {
+		command = "bitfield_ro"
+	}
+
+	args := make([]interface{}, 0, len(operations)*4+2)
+	args = append(args, command, key)
+	for _, op := range operations {
+		switch op.Op {
+		case "GET":
+			args = append(args, "get", op.Type, op.Offset)
+		case "SET":
+			args = append(args, "set", op.Type, op.Offset, op.Value)
+		case "INCRBY":
+			args = append(args, "incrby", op.Type, op.Offset, op.Increment)
+		case "OVERFLOW":
+			args = append(args, "overflow", op.Overflow)
+		}
+	}
+
+	result := redis.NewSliceCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// bitRangeArgs builds the [start [end [BYTE | BIT]]] arguments of BITCOUNT and BITPOS
+func bitRangeArgs(start, end *int64, unit string) []interface{} {
+	var args []interface{}
+	if start == nil {
+		return args
+	}
+	args = append(args, *start)
+	if end == nil {
+		return args
+	}
+	args = append(args, *end)
+	if unit != "" {
+		args = append(args, unit)
+	}
+	return args
+}
+
+// HLLPFAdd adds elements to a HyperLogLog and reports whether it was modified
+func (r *RedisConnImpl) HLLPFAdd(ctx context.Context, key string, elements []string) (int64, error) {
+	els := make([]interface{}, len(elements))
+	for i, element := range elements {
+		els[i] = element
+	}
+	result := r.client.PFAdd(ctx, key, els...)
+	return result.Val(), result.Err()
+}
+
+// HLLPFCount returns the approximated cardinality of the union of the HyperLogLogs
+func (r *RedisConnImpl) HLLPFCount(ctx context.Context, keys []string) (int64, error) {
+	result := r.client.PFCount(ctx, keys...)
+	return result.Val(), result.Err()
+}
+
+// HLLPFMerge merges HyperLogLogs into destination
+func (r *RedisConnImpl) HLLPFMerge(ctx context.Context, destination string, keys []string) (string, error) {
+	result := r.client.PFMerge(ctx, destination, keys...)
+	return result.Val(), result.Err()
+}
+
+// GeoAdd adds locations to a geospatial index, honouring the NX/XX/CH flags
+func (r *RedisConnImpl) GeoAdd(ctx context.Context, key string, locations []types.GeoLocation, flags types.GeoAddFlags) (int64, error) {
+	// GEOADD key [NX | XX] [CH] longitude latitude member [...]
+	args := make([]interface{}, 0, len(locations)*3+4)
+	args = append(args, "geoadd", key)
+	if flags.NX {
+		args = append(args, "nx")
+	}
+	if flags.XX {
+		args = append(args, "xx")
+	}
+	if flags.CH {
+		args = append(args, "ch")
+	}
+	for _, loc := range locations {
+		args = append(args, loc.Longitude, loc.Latitude, loc.Member)
+	}
+	result := redis.NewIntCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// GeoPos gets the positions of members; missing members yield nil entries
+func (r *RedisConnImpl) GeoPos(ctx context.Context, key string, members []string) ([]*types.GeoPosition, error) {
+	result := r.client.GeoPos(ctx, key, members...)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	positions := make([]*types.GeoPosition, len(result.Val()))
+	for i, pos := range result.Val() {
+		if pos != nil {
+			positions[i] = &types.GeoPosition{Longitude: pos.Longitude, Latitude: pos.Latitude}
+		}
+	}
+	return positions, nil
+}
+
+// GeoDist gets the distance between two members, or nil if either is missing
+func (r *RedisConnImpl) GeoDist(ctx context.Context, key string, member1, member2, unit string) (*float64, error) {
+	result := r.client.GeoDist(ctx, key, member1, member2, unit)
+	if result.Err() == redis.Nil {
+		return nil, nil // One of the members does not exist
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	dist := result.Val()
+	return &dist, nil
+}
+
+// GeoHash gets the geohash strings of members; missing members yield empty strings
+func (r *RedisConnImpl) GeoHash(ctx context.Context, key string, members []string) ([]string, error) {
+	result := r.client.GeoHash(ctx, key, members...)
+	return result.Val(), result.Err()
+}
+
+// GeoSearch searches members within a radius or box around a member or a point
+func (r *RedisConnImpl) GeoSearch(ctx context.Context, key string, opt types.GeoSearchOptions, withCoord, withDist bool) ([]types.GeoSearchLocation, error) {
+	// Without WITH* options the reply is a flat list of member names,
+	// which GeoSearchLocationCmd cannot parse
+	if !withCoord && !withDist {
+		query := geoSearchQuery(opt)
+		result := r.client.GeoSearch(ctx, key, &query)
+		if result.Err() != nil {
+			return nil, result.Err()
+		}
+		locations := make([]types.GeoSearchLocation, len(result.Val()))
+		for i, member := range result.Val() {
+			locations[i] = types.GeoSearchLocation{Member: member}
+		}
+		return locations, nil
+	}
+
+	query := &redis.GeoSearchLocationQuery{
+		GeoSearchQuery: geoSearchQuery(opt),
+		WithCoord:      withCoord,
+		WithDist:       withDist,
+	}
+	result := r.client.GeoSearchLocation(ctx, key, query)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	locations := make([]types.GeoSearchLocation, len(result.Val()))
+	for i, loc := range result.Val() {
+		locations[i] = types.GeoSearchLocation{Member: loc.Name}
+		if withDist {
+			dist := loc.Dist
+			locations[i].Distance = &dist
+		}
+		if withCoord {
+			locations[i].Position = &types.GeoPosition{Longitude: loc.Longitude, Latitude: loc.Latitude}
+		}
+	}
+	return locations, nil
+}
+
+// GeoSearchStore stores the result of a geo search in destination
+func (r *RedisConnImpl) GeoSearchStore(ctx context.Context, destination, key string, opt types.GeoSearchOptions, storeDist bool) (int64, error) {
+	query := &redis.GeoSearchStoreQuery{
+		GeoSearchQuery: geoSearchQuery(opt),
+		StoreDist:      storeDist,
+	}
+	result := r.client.GeoSearchStore(ctx, key, destination, query)
+	return result.Val(), result.Err()
+}
+
+// geoSearchQuery converts the request search options to a go-redis query.
+// A non-empty member selects FROMMEMBER and a positive radius selects BYRADIUS.
+func geoSearchQuery(opt types.GeoSearchOptions) redis.GeoSearchQuery {
+	query := redis.GeoSearchQuery{
+		Member:     opt.Member,
+		Radius:     opt.Radius,
+		RadiusUnit: opt.Unit,
+		BoxWidth:   opt.Width,
+		BoxHeight:  opt.Height,
+		BoxUnit:    opt.Unit,
+		Sort:       opt.Sort,
+		Count:      int(opt.Count),
+		CountAny:   opt.Any,
+	}
+	if opt.Longitude != nil && opt.Latitude != nil {
+		query.Longitude = *opt.Longitude
+		query.Latitude = *opt.Latitude
+	}
+	return query
+}
+
+// ScriptEval runs a Lua script with EVAL, or EVAL_RO when readOnly is set
+func (r *RedisConnImpl) ScriptEval(ctx context.Context, script string, keys, args []string, readOnly bool) (interface{}, error) {
+	command := "eval"
+	if readOnly {
+		command = "eval_ro"
+	}
+	return r.evalScript(ctx, command, script, keys, args)
+}
+
+// ScriptEvalSha runs a cached Lua script with EVALSHA, or EVALSHA_RO when readOnly is set
+func (r *RedisConnImpl) ScriptEvalSha(ctx context.Context, sha string, keys, args []string, readOnly bool) (interface{}, error) {
+	command := "evalsha"
+	if readOnly {
+		command = "evalsha_ro"
+	}
+	return r.evalScript(ctx, command, sha, keys, args)
+}
+
+// ScriptLoad loads a script into the script cache and returns its SHA1.
+// On sharded targets the script is loaded on every live node.
+func (r *RedisConnImpl) ScriptLoad(ctx context.Context, script string) (string, error) {
+	if ring, ok := r.client.(*redis.Ring); ok {
+		err := ring.ForEachShard(ctx, func(ctx context.Context, client *redis.Client) error {
+			return client.ScriptLoad(ctx, script).Err()
+		})
+		if err != nil {
+			return "", err
+		}
+		digest := sha1.Sum([]byte(script))
+		return hex.EncodeToString(digest[:]), nil
+	}
+	result := r.client.ScriptLoad(ctx, script)
+	return result.Val(), result.Err()
+}
+
+// ScriptExists checks whether scripts are present in the script cache.
+// On sharded targets a script exists only when every live node has it.
+func (r *RedisConnImpl) ScriptExists(ctx context.Context, shas []string) ([]bool, error) {
+	if ring, ok := r.client.(*redis.Ring); ok {
+		exists := make([]bool, len(shas))
+		for i := range exists {
+			exists[i] = true
+		}
+		var mu sync.Mutex
+		err := ring.ForEachShard(ctx, func(ctx context.Context, client *redis.Client) error {
+			result, err := client.ScriptExists(ctx, shas...).Result()
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for i := range exists {
+				exists[i] = exists[i] && i < len(result) && result[i]
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return exists, nil
+	}
+	result := r.client.ScriptExists(ctx, shas...)
+	return result.Val(), result.Err()
+}
+
+// ScriptFlush empties the script cache, optionally in ASYNC or SYNC mode.
+// On sharded targets every live node is flushed.
+func (r *RedisConnImpl) ScriptFlush(ctx context.Context, mode string) (string, error) {
+	args := []interface{}{"script", "flush"}
+	if mode != "" {
+		args = append(args, mode)
+	}
+	if ring, ok := r.client.(*redis.Ring); ok {
+		err := ring.ForEachShard(ctx, func(ctx context.Context, client *redis.Client) error {
+			return client.Do(ctx, args...).Err()
+		})
+		if err != nil {
+			return "", err
+		}
+		return "OK", nil
+	}
+	result := redis.NewStatusCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// evalScript sends an EVAL- or FCALL-family command, whose arguments share the
+// <script|sha|function> numkeys key [key ...] arg [arg ...] layout.
+// A nil script reply is returned as nil.
+func (r *RedisConnImpl) evalScript(ctx context.Context, command, script string, keys, args []string) (interface{}, error) {
+	cmdArgs := make([]interface{}, 0, len(keys)+len(args)+3)
+	cmdArgs = append(cmdArgs, command, script, len(keys))
+	for _, key := range keys {
+		cmdArgs = append(cmdArgs, key)
+	}
+	for _, arg := range args {
+		cmdArgs = append(cmdArgs, arg)
+	}
+
+	result := redis.NewCmd(ctx, cmdArgs...)
+	_ = r.client.Process(ctx, result)
+	if result.Err() == redis.Nil {
+		return nil, nil // Script returned nil
+	}
+	return result.Val(), result.Err()
+}
+
+// FunctionLoad loads a function library and returns its name.
+// On cluster and sharded targets the library is loaded on every primary.
+func (r *RedisConnImpl) FunctionLoad(ctx context.Context, code string, replace bool) (string, error) {
+	args := []interface{}{"function", "load"}
+	if replace {
+		args = append(args, "replace")
+	}
+	args = append(args, code)
+
+	var name string
+	var mu sync.Mutex
+	fanned, err := r.forEachPrimary(ctx, func(ctx context.Context, client *redis.Client) error {
+		loaded, err := client.Do(ctx, args...).Text()
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		name = loaded
+		return nil
+	})
+	if fanned {
+		return name, err
+	}
+
+	result := redis.NewStringCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// FunctionList lists function libraries, optionally filtered by a name pattern
+func (r *RedisConnImpl) FunctionList(ctx context.Context, libraryName string, withCode bool) ([]types.FunctionLibrary, error) {
+	args := []interface{}{"function", "list"}
+	if libraryName != "" {
+		args = append(args, "libraryname", libraryName)
+	}
+	if withCode {
+		args = append(args, "withcode")
+	}
+	result := redis.NewSliceCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	libraries := make([]types.FunctionLibrary, 0, len(result.Val()))
+	for _, item := range result.Val() {
+		fields := replyMap(item)
+		library := types.FunctionLibrary{
+			LibraryName: replyString(fields["library_name"]),
+			Engine:      replyString(fields["engine"]),
+			LibraryCode: replyString(fields["library_code"]),
+			Functions:   []types.FunctionInfo{},
+		}
+		functions, _ := fields["functions"].([]interface{})
+		for _, fn := range functions {
+			fnFields := replyMap(fn)
+			info := types.FunctionInfo{
+				Name:        replyString(fnFields["name"]),
+				Description: replyString(fnFields["description"]),
+				Flags:       []string{},
+			}
+			flags, _ := fnFields["flags"].([]interface{})
+			for _, flag := range flags {
+				info.Flags = append(info.Flags, replyString(flag))
+			}
+			library.Functions = append(library.Functions, info)
+		}
+		libraries = append(libraries, library)
+	}
+	return libraries, nil
+}
+
+// FunctionDelete deletes a function library.
+// On cluster and sharded targets the library is deleted on every primary.
+func (r *RedisConnImpl) FunctionDelete(ctx context.Context, libraryName string) (string, error) {
+	fanned, err := r.forEachPrimary(ctx, func(ctx context.Context, client *redis.Client) error {
+		return client.Do(ctx, "function", "delete", libraryName).Err()
+	})
+	if fanned {
+		return fannedStatus(err)
+	}
+
+	result := redis.NewStatusCmd(ctx, "function", "delete", libraryName)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// FunctionDump returns the serialized payload of all function libraries.
+// On cluster and sharded targets every primary holds the same libraries, any one of them is dumped.
+func (r *RedisConnImpl) FunctionDump(ctx context.Context) (string, error) {
+	result := redis.NewStringCmd(ctx, "function", "dump")
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// FunctionRestore restores function libraries from a FUNCTION DUMP payload.
+// On cluster and sharded targets the payload is restored on every primary.
+func (r *RedisConnImpl) FunctionRestore(ctx context.Context, payload, policy string) (string, error) {
+	args := []interface{}{"function", "restore", payload}
+	if policy != "" {
+		args = append(args, policy)
+	}
+	fanned, err := r.forEachPrimary(ctx, func(ctx context.Context, client *redis.Client) error {
+		return client.Do(ctx, args...).Err()
+	})
+	if fanned {
+		return fannedStatus(err)
+	}
+
+	result := redis.NewStatusCmd(ctx, args...)
+	_ = r.client.Process(ctx, result)
+	return result.Val(), result.Err()
+}
+
+// FunctionCall invokes a function with FCALL, or FCALL_RO when readOnly is set
+func (r *RedisConnImpl) FunctionCall(ctx context.Context, function string, keys, args []string, readOnly bool) (interface{}, error) {
+	command := "fcall"
+	if readOnly {
+		command = "fcall_ro"
+	}
+	return r.evalScript(ctx, command, function, keys, args)
+}
+
+// Pipeline operations
+
+// Pipeline sends the commands in one round trip per node and returns their replies in order.
+// Redis errors are reported per command, connection errors fail the whole pipeline.
+func (r *RedisConnImpl) Pipeline(ctx context.Context, commands [][]string) ([]types.PipelineResult, error) {
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.Cmd, len(commands))
+	for i, command := range commands {
+		args := make([]interface{}, len(command))
+		for j, arg := range command {
+			args[j] = arg
+		}
+		cmds[i] = pipe.Do(ctx, args...)
+	}
+	// Per-command errors are collected below
+	_, _ = pipe.Exec(ctx)
+
+	results := make([]types.PipelineResult, len(cmds))
+	for i, cmd := range cmds {
+		value, err := cmd.Result()
+		switch {
+		case err == redis.Nil:
+		case err != nil:
+			var redisErr redis.Error
+			if !errors.As(err, &redisErr) {
+				return nil, err
+			}
+			results[i].Error = err.Error()
+		default:
+			results[i].Result = value
+		}
+	}
+	return results, nil
+}
+
+// replyMap converts a flat key, value, key, value... RESP2 reply to a map
+func replyMap(reply interface{}) map[string]interface{} {
+	items, _ := reply.([]interface{})
+	m := make(map[string]interface{}, len(items)/2)
+	for i := 0; i+1 < len(items); i += 2 {
+		m[replyString(items[i])] = items[i+1]
+	}
+	return m
+}
+
+// replyString returns a string reply value, or "" for nil and other types
+func replyString(reply interface{}) string {
+	s, _ := reply.(string)
+	return s
+}
@@ -0,0 +1,224 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/metrics"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+const (
+	// replicaCheckInterval is how often replica lag and latency are measured
+	replicaCheckInterval = time.Second
+	// replicaCheckTimeout bounds a single round of checks
+	replicaCheckTimeout = 500 * time.Millisecond
+)
+
+func init() {
+	metrics.Register("redis_replica_in_rotation", "Whether a replica currently serves reads (1) or not (0).", metrics.KindGauge)
+	metrics.Register("redis_replica_lag_seconds", "Replication lag reported by the primary for each replica.", metrics.KindGauge)
+}
+
+// replicaNode is a replica of a standalone target together with its last check
+type replicaNode struct {
+	addr   string
+	client *redis.Client
+
+	// ids are the ip:port forms the primary may list the replica under
+	ids []string
+
+	inRotation atomic.Bool
+	lag        atomic.Int64 // seconds, -1 when unknown
+	latency    atomic.Int64 // nanoseconds of the last PING
+}
+
+// newReplicaNodes creates the replica clients of a standalone target, sharing its credentials and TLS settings
+func newReplicaNodes(cfg config.TargetConfig, tlsConfig *tls.Config) []*replicaNode {
+	nodes := make([]*replicaNode, len(cfg.Replicas))
+	for i, addr := range cfg.Replicas {
+		nodes[i] = &replicaNode{
+			addr:   addr,
+			client: redis.NewClient(nodeOptions(cfg, addr, tlsConfig)),
+		}
+		nodes[i].lag.Store(-1)
+	}
+	return nodes
+}
+
+// readClient picks the client serving a read according to the target's read policy
+func (t *target) readClient() redis.UniversalClient {
+	switch t.readPolicy {
+	case config.ReadPolicyReplicaPreferred:
+		healthy := t.healthyReplicas()
+		if len(healthy) == 0 {
+			return t.primary
+		}
+		return healthy[t.next.Add(1)%uint64(len(healthy))].client
+	case config.ReadPolicyNearest:
+		var client redis.UniversalClient = t.primary
+		best := t.primaryLatency.Load()
+		for _, node := range t.healthyReplicas() {
+			if latency := node.latency.Load(); best <= 0 || latency < best {
+				client, best = node.client, latency
+			}
+		}
+		return client
+	default:
+		return t.primary
+	}
+}
+
+// healthyReplicas returns the replicas currently in the read rotation
+func (t *target) healthyReplicas() []*replicaNode {
+	healthy := make([]*replicaNode, 0, len(t.replicas))
+	for _, node := range t.replicas {
+		if node.inRotation.Load() {
+			healthy = append(healthy, node)
+		}
+	}
+	return healthy
+}
+
+// watchReplicas periodically measures replica lag and latency until ctx is cancelled
+func (m *TargetManager) watchReplicas(ctx context.Context, t *target, maxLag int) {
+	defer m.wg.Done()
+
+	for _, node := range t.replicas {
+		node.ids = replicaIDs(node.addr)
+	}
+
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+		t.checkReplicas(checkCtx, int64(maxLag))
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkReplicas reads the replica lag from the primary's INFO replication and pings every node.
+// A replica stays in rotation while it answers and lags no more than maxLag seconds.
+// When the primary cannot be reached the lag is unknown and answering replicas keep serving reads.
+func (t *target) checkReplicas(ctx context.Context, maxLag int64) {
+	start := time.Now()
+	info, err := t.primary.Info(ctx, "replication").Result()
+	primaryUp := err == nil
+	if primaryUp {
+		t.primaryLatency.Store(int64(time.Since(start)))
+	}
+	lags := replicaLags(info)
+
+	for _, node := range t.replicas {
+		start := time.Now()
+		pingErr := node.client.Ping(ctx).Err()
+		if pingErr == nil {
+			node.latency.Store(int64(time.Since(start)))
+		}
+
+		lag := int64(-1)
+		for _, id := range node.ids {
+			if l, ok := lags[id]; ok {
+				lag = l
+				break
+			}
+		}
+		node.lag.Store(lag)
+
+		inRotation := pingErr == nil && (!primaryUp || (lag >= 0 && lag <= maxLag))
+		labels := metrics.Labels{"target": t.name, "replica": node.addr}
+		if lag >= 0 {
+			metrics.Set("redis_replica_lag_seconds", labels, float64(lag))
+		}
+		if node.inRotation.Swap(inRotation) != inRotation {
+			fields := logrus.Fields{"target": t.name, "replica": node.addr, "lag": lag}
+			if inRotation {
+				logger.Info("Redis replica returned to read rotation", fields)
+			} else {
+				if pingErr != nil {
+					fields["error"] = pingErr.Error()
+				}
+				logger.Warn("Redis replica removed from read rotation", fields)
+			}
+		}
+		if inRotation {
+			metrics.Set("redis_replica_in_rotation", labels, 1)
+		} else {
+			metrics.Set("redis_replica_in_rotation", labels, 0)
+		}
+	}
+}
+
+// replicaLags parses the slaveN lines of INFO replication into lag seconds keyed by ip:port, e.g.
+// slave0:ip=127.0.0.1,port=6380,state=online,offset=1234,lag=0
+func replicaLags(info string) map[string]int64 {
+	lags := make(map[string]int64)
+	for _, line := range strings.Split(info, "\r\n") {
+		if !strings.HasPrefix(line, "slave") || !strings.Contains(line, ":ip=") {
+			continue
+		}
+		fields := make(map[string]string)
+		for _, pair := range strings.Split(line[strings.IndexByte(line, ':')+1:], ",") {
+			if k, v, ok := strings.Cut(pair, "="); ok {
+				fields[k] = v
+			}
+		}
+		if fields["state"] != "online" {
+			continue
+		}
+		lag, err := strconv.ParseInt(fields["lag"], 10, 64)
+		if err != nil {
+			continue
+		}
+		lags[net.JoinHostPort(fields["ip"], fields["port"])] = lag
+	}
+	return lags
+}
+
+// replicaIDs resolves a configured replica address into the ip:port forms reported by the primary
+func replicaIDs(addr string) []string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return []string{addr}
+	}
+	ids := []string{addr}
+	if ips, err := net.LookupHost(host); err == nil {
+		for _, ip := range ips {
+			ids = append(ids, net.JoinHostPort(ip, port))
+		}
+	}
+	return ids
+}
+
+// replicaHealth reports the state of every replica of a target
+func (t *target) replicaHealth() []types.ReplicaHealth {
+	if len(t.replicas) == 0 {
+		return nil
+	}
+	report := make([]types.ReplicaHealth, len(t.replicas))
+	for i, node := range t.replicas {
+		report[i] = types.ReplicaHealth{
+			Addr:       node.addr,
+			InRotation: node.inRotation.Load(),
+			LagSeconds: node.lag.Load(),
+			LatencyMS:  float64(node.latency.Load()) / float64(time.Millisecond),
+		}
+	}
+	return report
+}
@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+)
+
+// idempotentCommands are the read-only commands that may be sent again after a transient failure
+var idempotentCommands = map[string]bool{
+	// String and keys
+	"get": true, "mget": true, "strlen": true, "getrange": true,
+	"exists": true, "type": true, "ttl": true, "pttl": true,
+	// List
+	"lindex": true, "llen": true, "lrange": true,
+	// Set
+	"scard": true, "sismember": true, "smismember": true, "smembers": true, "srandmember": true,
+	"sinter": true, "sintercard": true, "sunion": true, "sdiff": true,
+	// Sorted set
+	"zcard": true, "zcount": true, "zlexcount": true, "zscore": true, "zmscore": true,
+	"zrank": true, "zrevrank": true, "zrange": true, "zrangebyscore": true, "zrangebylex": true,
+	"zrevrange": true, "zrevrangebyscore": true, "zrevrangebylex": true, "zrandmember": true,
+	"zinter": true, "zunion": true, "zdiff": true, "zintercard": true,
+	// Hash
+	"hget": true, "hmget": true, "hgetall": true, "hkeys": true, "hvals": true, "hlen": true,
+	"hexists": true, "hstrlen": true, "hrandfield": true, "httl": true, "hpttl": true,
+	// Bitmap and HyperLogLog
+	"getbit": true, "bitcount": true, "bitpos": true, "bitfield_ro": true, "pfcount": true,
+	// Geo
+	"geopos": true, "geodist": true, "geohash": true, "geosearch": true,
+	"georadius_ro": true, "georadiusbymember_ro": true,
+	// Read-only scripts and functions
+	"evalsha_ro": true, "eval_ro": true, "fcall_ro": true,
+	// Server
+	"ping": true, "info": true,
+}
+
+// retryHook sends an idempotent read command again when it failed with a transient error.
+// The retry runs through the client's hooks like the first attempt, so the circuit breaker
+// sees every attempt, and the command ends up with the result of the last one.
+// The hook must be added before any hook that inspects the final result.
+type retryHook struct {
+	client redis.UniversalClient
+	policy config.RetryConfig
+}
+
+type retryAttemptKey struct{}
+
+// newRetryHook creates the retry hook of a client, nil when retries are disabled
+func newRetryHook(client redis.UniversalClient, policy config.RetryConfig) redis.Hook {
+	if policy.MaxRetries <= 0 {
+		return nil
+	}
+	return retryHook{client: client, policy: policy}
+}
+
+func (h retryHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h retryHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	if attempt >= h.policy.MaxRetries || !idempotentCommands[cmd.Name()] || !transientError(cmd.Err()) {
+		return nil
+	}
+
+	timer := time.NewTimer(h.backoff(attempt))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		return nil
+	}
+	_ = h.client.Process(context.WithValue(ctx, retryAttemptKey{}, attempt+1), cmd)
+	return nil
+}
+
+// Pipelines may mix reads and writes and are never retried
+func (h retryHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h retryHook) AfterProcessPipeline(context.Context, []redis.Cmder) error {
+	return nil
+}
+
+// backoff doubles the delay with every attempt up to the maximum and randomizes its upper half,
+// so that clients failing together do not retry together
+func (h retryHook) backoff(attempt int) time.Duration {
+	minBackoff := msDuration(h.policy.MinBackoffMS)
+	maxBackoff := msDuration(h.policy.MaxBackoffMS)
+	d := minBackoff << uint(attempt)
+	if d > maxBackoff || d < minBackoff {
+		d = maxBackoff
+	}
+	half := int64(d / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// transientError reports whether a failed command is worth sending again: the connection
+// broke or the server is briefly unable to serve. Timeouts have used up the caller's budget
+// and are not retried.
+func transientError(err error) bool {
+	if err == nil || err == redis.Nil {
+		return false
+	}
+	switch ClassifyError(err) {
+	case ErrorConnection, ErrorLoading, ErrorMasterDown, ErrorTryAgain, ErrorClusterDown:
+		return true
+	}
+	return false
+}
+
+// IsTimeout reports whether a Redis call failed because a dial, read, write or pool wait
+// timed out, or the request deadline passed
+func IsTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	// The pool timeout error of go-redis is not exported
+	return err.Error() == "redis: connection pool timeout"
+}
+
+// msDuration converts a configured number of milliseconds
+func msDuration(ms int) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
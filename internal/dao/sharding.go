@@ -0,0 +1,199 @@
+package dao
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/tls"
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	redis "github.com/go-redis/redis/v8"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// ketamaPointsPerHash is the number of ring points taken from one MD5 digest,
+// and ketamaHashesPerNode the digests per node, giving libketama's 160 points
+const (
+	ketamaPointsPerHash = 4
+	ketamaHashesPerNode = 40
+)
+
+// newConsistentHash returns the constructor of the configured hash algorithm
+func newConsistentHash(algorithm string) func(nodes []string) redis.ConsistentHash {
+	if algorithm == config.ShardHashRendezvous {
+		return newRendezvousHash
+	}
+	return newKetamaHash
+}
+
+// ketamaHash places every node on a ring at 160 points derived from MD5,
+// compatible with libketama. A key belongs to the first point at or after its hash.
+type ketamaHash struct {
+	points []uint32
+	nodes  []string
+}
+
+func newKetamaHash(nodes []string) redis.ConsistentHash {
+	type point struct {
+		hash uint32
+		node string
+	}
+	points := make([]point, 0, len(nodes)*ketamaHashesPerNode*ketamaPointsPerHash)
+	for _, node := range nodes {
+		for i := 0; i < ketamaHashesPerNode; i++ {
+			digest := md5.Sum([]byte(node + "-" + strconv.Itoa(i)))
+			for j := 0; j < ketamaPointsPerHash; j++ {
+				points = append(points, point{binary.LittleEndian.Uint32(digest[j*4:]), node})
+			}
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash != points[j].hash {
+			return points[i].hash < points[j].hash
+		}
+		return points[i].node < points[j].node
+	})
+
+	h := &ketamaHash{
+		points: make([]uint32, len(points)),
+		nodes:  make([]string, len(points)),
+	}
+	for i, p := range points {
+		h.points[i] = p.hash
+		h.nodes[i] = p.node
+	}
+	return h
+}
+
+// Get returns the node owning key, or "" when no node is available
+func (h *ketamaHash) Get(key string) string {
+	if len(h.points) == 0 {
+		return ""
+	}
+	digest := md5.Sum([]byte(key))
+	hash := binary.LittleEndian.Uint32(digest[:4])
+	i := sort.Search(len(h.points), func(i int) bool { return h.points[i] >= hash })
+	if i == len(h.points) {
+		i = 0
+	}
+	return h.nodes[i]
+}
+
+// rendezvousHash assigns a key to the node with the highest combined score
+// (highest random weight), so removing a node only moves the keys it owned.
+type rendezvousHash struct {
+	nodes  []string
+	hashes []uint64
+}
+
+func newRendezvousHash(nodes []string) redis.ConsistentHash {
+	h := &rendezvousHash{
+		nodes:  nodes,
+		hashes: make([]uint64, len(nodes)),
+	}
+	for i, node := range nodes {
+		h.hashes[i] = fnv64a(node)
+	}
+	return h
+}
+
+// Get returns the node owning key, or "" when no node is available
+func (h *rendezvousHash) Get(key string) string {
+	keyHash := fnv64a(key)
+	var best string
+	var bestScore uint64
+	for i, nodeHash := range h.hashes {
+		score := mix64(keyHash ^ nodeHash)
+		if best == "" || score > bestScore {
+			best, bestScore = h.nodes[i], score
+		}
+	}
+	return best
+}
+
+func fnv64a(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix64 is the splitmix64 finalizer, spreading the combined hash over all bits
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// shardNode is a node of a sharded target, created by the ring
+type shardNode struct {
+	name   string
+	addr   string
+	client *redis.Client
+}
+
+// ringOptions builds the ring client of a sharded target. The ring rebuilds the
+// consistent hash whenever a node goes down or comes back, the latest hash is kept
+// so that multi-key operations group keys exactly as the ring routes them.
+func (t *target) ringOptions(cfg config.TargetConfig, tlsConfig *tls.Config) *redis.RingOptions {
+	addrs := make(map[string]string, len(cfg.Sharded.Nodes))
+	for _, node := range cfg.Sharded.Nodes {
+		addrs[node.Name] = node.Addr
+	}
+	newHash := newConsistentHash(cfg.Sharded.Hash)
+
+	return &redis.RingOptions{
+		Addrs:        addrs,
+		Username:     cfg.Username,
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		TLSConfig:    tlsConfig,
+		OnConnect:    clientNameHook(cfg.ClientName),
+		DialTimeout:  msDuration(cfg.Timeouts.DialMS),
+		ReadTimeout:  msDuration(cfg.Timeouts.ReadMS),
+		WriteTimeout: msDuration(cfg.Timeouts.WriteMS),
+		PoolTimeout:  msDuration(cfg.Timeouts.PoolMS),
+		MaxRetries:   -1,
+		NewClient: func(name string, opt *redis.Options) *redis.Client {
+			// Called once per node while NewRing builds the shards
+			client := redis.NewClient(opt)
+			t.shards = append(t.shards, &shardNode{name: name, addr: opt.Addr, client: client})
+			sort.Slice(t.shards, func(i, j int) bool { return t.shards[i].name < t.shards[j].name })
+			return client
+		},
+		NewConsistentHash: func(nodes []string) redis.ConsistentHash {
+			hash := newHash(nodes)
+			t.shardHash.Store(&hash)
+			return hash
+		},
+	}
+}
+
+// shardGroup names the node owning a key on a sharded target
+func (t *target) shardGroup(key string) string {
+	hash, ok := t.shardHash.Load().(*redis.ConsistentHash)
+	if !ok {
+		return ""
+	}
+	return (*hash).Get(hashTag(key))
+}
+
+// shardHealth pings every node of a sharded target, a node that is down has its keys
+// moved to the remaining nodes by the ring, so the target is reported down as well
+func (t *target) shardHealth(ctx context.Context) []types.ShardHealth {
+	report := make([]types.ShardHealth, len(t.shards))
+	for i, node := range t.shards {
+		report[i] = types.ShardHealth{Name: node.name, Addr: node.addr, Status: "up"}
+		if err := node.client.Ping(ctx).Err(); err != nil {
+			report[i].Status = "down"
+			report[i].Error = err.Error()
+		}
+	}
+	return report
+}
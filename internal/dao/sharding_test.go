@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"fmt"
+	"testing"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+const shardTestKeys = 20000
+
+var shardHashes = []struct {
+	name string
+	new  func(nodes []string) redis.ConsistentHash
+}{
+	{"ketama", newKetamaHash},
+	{"rendezvous", newRendezvousHash},
+}
+
+func shardNodes(n int) []string {
+	nodes := make([]string, n)
+	for i := range nodes {
+		nodes[i] = fmt.Sprintf("shard-%d", i)
+	}
+	return nodes
+}
+
+func shardOwners(h redis.ConsistentHash) []string {
+	owners := make([]string, shardTestKeys)
+	for i := range owners {
+		owners[i] = h.Get(fmt.Sprintf("key:%d", i))
+	}
+	return owners
+}
+
+func TestConsistentHashRemoveNode(t *testing.T) {
+	for _, tc := range shardHashes {
+		t.Run(tc.name, func(t *testing.T) {
+			nodes := shardNodes(5)
+			before := shardOwners(tc.new(nodes))
+			removed := nodes[2]
+			after := shardOwners(tc.new(append(append([]string{}, nodes[:2]...), nodes[3:]...)))
+
+			moved := 0
+			for i := range before {
+				if after[i] == removed {
+					t.Fatalf("key:%d still maps to the removed node", i)
+				}
+				if before[i] != after[i] {
+					if before[i] != removed {
+						t.Fatalf("key:%d moved from %s to %s, only keys of %s may move", i, before[i], after[i], removed)
+					}
+					moved++
+				}
+			}
+			if moved == 0 {
+				t.Fatalf("no key was owned by %s", removed)
+			}
+		})
+	}
+}
+
+func TestConsistentHashAddNode(t *testing.T) {
+	for _, tc := range shardHashes {
+		t.Run(tc.name, func(t *testing.T) {
+			nodes := shardNodes(4)
+			before := shardOwners(tc.new(nodes))
+			added := "shard-new"
+			after := shardOwners(tc.new(append(append([]string{}, nodes...), added)))
+
+			moved := 0
+			for i := range before {
+				if before[i] != after[i] {
+					if after[i] != added {
+						t.Fatalf("key:%d moved from %s to %s, keys may only move to %s", i, before[i], after[i], added)
+					}
+					moved++
+				}
+			}
+			// 1/(N+1) of the keys are expected to move to the new node
+			want := shardTestKeys / (len(nodes) + 1)
+			if moved < want*6/10 || moved > want*14/10 {
+				t.Fatalf("%d of %d keys moved, want about %d", moved, shardTestKeys, want)
+			}
+		})
+	}
+}
+
+func TestConsistentHashEmpty(t *testing.T) {
+	for _, tc := range shardHashes {
+		t.Run(tc.name, func(t *testing.T) {
+			if node := tc.new(nil).Get("key"); node != "" {
+				t.Fatalf("Get() = %q without nodes, want \"\"", node)
+			}
+		})
+	}
+}
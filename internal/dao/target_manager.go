@@ -0,0 +1,437 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/metrics"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+const (
+	// failoverChannel is the sentinel event published after a master switch
+	failoverChannel = "+switch-master"
+	// failoverWatchInterval bounds how long the watcher waits before pinging an idle sentinel
+	failoverWatchInterval = 10 * time.Second
+	// failoverRetryDelay is the pause before the watcher moves on to the next sentinel
+	failoverRetryDelay = time.Second
+)
+
+// ErrUnknownTarget is returned by Connect when a request names a target that is not configured
+var ErrUnknownTarget = errors.New("unknown redis target")
+
+func init() {
+	metrics.Register("redis_sentinel_failovers_total", "Number of master switches observed per sentinel target.", metrics.KindCounter)
+}
+
+// TargetManager owns the long-lived clients of the named targets.
+// Unlike per-request connections, target clients are shared and must not be closed by callers.
+type TargetManager struct {
+	targets map[string]*target
+	// addrs holds the circuit breakers of connections made from a request addr
+	addrs *addrBreakers
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// target holds the clients of a single named target
+type target struct {
+	name    string
+	kind    string
+	primary redis.UniversalClient
+	// replica serves read-only operations, nil unless replica reads are enabled
+	replica redis.UniversalClient
+
+	// replicas and readPolicy route the reads of standalone targets
+	replicas       []*replicaNode
+	readPolicy     string
+	primaryLatency atomic.Int64 // nanoseconds of the last INFO replication
+	next           atomic.Uint64
+
+	// shards and shardHash place the keys of sharded targets
+	shards    []*shardNode
+	shardHash atomic.Value // redis.ConsistentHash over the live shards
+
+	// breaker guards the target, nil when disabled
+	breaker *breaker
+}
+
+// NewTargetManager creates clients for every configured target and starts the sentinel failover watchers.
+// The circuit breakers of connections made from a request addr use the defaults of redisCfg.
+func NewTargetManager(targets map[string]config.TargetConfig, redisCfg config.RedisConfig) (*TargetManager, func(), error) {
+	// Load certificates first so that a bad target leaves nothing to clean up
+	tlsConfigs := make(map[string]*tls.Config, len(targets))
+	for name, cfg := range targets {
+		tlsConfig, err := targetTLSConfig(cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("target %s: %w", name, err)
+		}
+		tlsConfigs[name] = tlsConfig
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &TargetManager{
+		targets: make(map[string]*target, len(targets)),
+		addrs: &addrBreakers{
+			cfg:      redisCfg.Breaker,
+			breakers: make(map[string]*breaker),
+		},
+		cancel: cancel,
+	}
+
+	for name, cfg := range targets {
+		t := &target{name: name, kind: cfg.Type}
+		tlsConfig := tlsConfigs[name]
+		switch cfg.Type {
+		case config.TargetTypeSentinel:
+			t.primary = redis.NewFailoverClient(failoverOptions(cfg, tlsConfig, false))
+			if cfg.Sentinel.ReplicaReads {
+				t.replica = redis.NewFailoverClient(failoverOptions(cfg, tlsConfig, true))
+			}
+			m.wg.Add(1)
+			go m.watchFailover(ctx, name, cfg.Sentinel, tlsConfig)
+		case config.TargetTypeCluster:
+			t.primary = redis.NewClusterClient(clusterOptions(cfg, tlsConfig, false))
+			if cfg.Cluster.ReplicaReads {
+				t.replica = redis.NewClusterClient(clusterOptions(cfg, tlsConfig, true))
+			}
+		case config.TargetTypeSharded:
+			t.primary = redis.NewRing(t.ringOptions(cfg, tlsConfig))
+		default:
+			t.primary = redis.NewClient(nodeOptions(cfg, cfg.Addr, tlsConfig))
+			if len(cfg.Replicas) > 0 {
+				t.replicas = newReplicaNodes(cfg, tlsConfig)
+				t.readPolicy = cfg.ReadPolicy
+				m.wg.Add(1)
+				go m.watchReplicas(ctx, t, cfg.MaxReplicaLag)
+			}
+		}
+		if cfg.Retry != nil {
+			// Added first so that the breaker below records each attempt rather than the final result
+			if hook := newRetryHook(t.primary, *cfg.Retry); hook != nil {
+				t.primary.AddHook(hook)
+			}
+			if t.replica != nil {
+				if hook := newRetryHook(t.replica, *cfg.Retry); hook != nil {
+					t.replica.AddHook(hook)
+				}
+			}
+			for _, node := range t.replicas {
+				if hook := newRetryHook(node.client, *cfg.Retry); hook != nil {
+					node.client.AddHook(hook)
+				}
+			}
+		}
+		if cfg.Breaker != nil {
+			t.breaker = newBreaker(name, *cfg.Breaker)
+		}
+		if t.breaker != nil {
+			// Standalone replicas are guarded by their own lag checks instead
+			t.primary.AddHook(breakerHook{breaker: t.breaker})
+			if t.replica != nil {
+				t.replica.AddHook(breakerHook{breaker: t.breaker})
+			}
+		}
+		m.targets[name] = t
+	}
+
+	return m, m.Close, nil
+}
+
+// nodeOptions builds the options of a single node of a standalone target
+func nodeOptions(cfg config.TargetConfig, addr string, tlsConfig *tls.Config) *redis.Options {
+	return &redis.Options{
+		Addr:         addr,
+		Username:     cfg.Username,
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		TLSConfig:    tlsConfig,
+		OnConnect:    clientNameHook(cfg.ClientName),
+		DialTimeout:  msDuration(cfg.Timeouts.DialMS),
+		ReadTimeout:  msDuration(cfg.Timeouts.ReadMS),
+		WriteTimeout: msDuration(cfg.Timeouts.WriteMS),
+		PoolTimeout:  msDuration(cfg.Timeouts.PoolMS),
+		MaxRetries:   -1, // read commands are retried by retryHook, writes never
+	}
+}
+
+// failoverOptions builds the sentinel client options, replica clients fall back to the master when no replica is available
+func failoverOptions(cfg config.TargetConfig, tlsConfig *tls.Config, replica bool) *redis.FailoverOptions {
+	return &redis.FailoverOptions{
+		MasterName:       cfg.Sentinel.MasterName,
+		SentinelAddrs:    cfg.Sentinel.Addrs,
+		SentinelUsername: cfg.Sentinel.Username,
+		SentinelPassword: cfg.Sentinel.Password,
+		Username:         cfg.Username,
+		Password:         cfg.Password,
+		DB:               cfg.DB,
+		SlaveOnly:        replica,
+		TLSConfig:        tlsConfig,
+		OnConnect:        clientNameHook(cfg.ClientName),
+		DialTimeout:      msDuration(cfg.Timeouts.DialMS),
+		ReadTimeout:      msDuration(cfg.Timeouts.ReadMS),
+		WriteTimeout:     msDuration(cfg.Timeouts.WriteMS),
+		PoolTimeout:      msDuration(cfg.Timeouts.PoolMS),
+		MaxRetries:       -1,
+	}
+}
+
+// clusterOptions builds the cluster client options, replica clients send read-only commands to replicas.
+// MOVED and ASK redirects are still followed by the cluster client itself.
+func clusterOptions(cfg config.TargetConfig, tlsConfig *tls.Config, replica bool) *redis.ClusterOptions {
+	return &redis.ClusterOptions{
+		Addrs:        cfg.Cluster.Addrs,
+		Username:     cfg.Username,
+		Password:     cfg.Password,
+		ReadOnly:     replica,
+		TLSConfig:    tlsConfig,
+		OnConnect:    clientNameHook(cfg.ClientName),
+		DialTimeout:  msDuration(cfg.Timeouts.DialMS),
+		ReadTimeout:  msDuration(cfg.Timeouts.ReadMS),
+		WriteTimeout: msDuration(cfg.Timeouts.WriteMS),
+		PoolTimeout:  msDuration(cfg.Timeouts.PoolMS),
+		MaxRetries:   -1,
+	}
+}
+
+// KeyGroup returns the function naming the hash slot or shard of a key on the named target,
+// nil for single-node targets where every key may be combined
+func (m *TargetManager) KeyGroup(name string) func(key string) string {
+	t, ok := m.targets[name]
+	if !ok {
+		return nil
+	}
+	switch t.kind {
+	case config.TargetTypeCluster:
+		return slotGroup
+	case config.TargetTypeSharded:
+		return t.shardGroup
+	default:
+		return nil
+	}
+}
+
+// Client returns the client of the named target, the replica client is used for reads when configured
+func (m *TargetManager) Client(name string, read bool) (redis.UniversalClient, error) {
+	t, ok := m.targets[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTarget, name)
+	}
+	if read && t.replica != nil {
+		return t.replica, nil
+	}
+	if read && len(t.replicas) > 0 {
+		return t.readClient(), nil
+	}
+	return t.primary, nil
+}
+
+// Allow reports whether the circuit breaker of the named target admits a request,
+// returning a *BreakerOpenError while it is open. The ticket is completed when the request is done.
+func (m *TargetManager) Allow(name string) (breakerTicket, error) {
+	t, ok := m.targets[name]
+	if !ok {
+		return breakerTicket{}, nil
+	}
+	return t.breaker.allow()
+}
+
+// AllowAddr is Allow for a connection made from a request addr. It also returns the hook
+// feeding the new client into the breaker of addr, nil when breakers are disabled.
+func (m *TargetManager) AllowAddr(addr string) (redis.Hook, breakerTicket, error) {
+	b := m.addrs.get(addr)
+	if b == nil {
+		return nil, breakerTicket{}, nil
+	}
+	ticket, err := b.allow()
+	if err != nil {
+		return nil, breakerTicket{}, err
+	}
+	return breakerHook{breaker: b}, ticket, nil
+}
+
+// Breakers reports the circuit breaker state of every named target followed by the request addrs in use
+func (m *TargetManager) Breakers() []types.BreakerStatus {
+	names := make([]string, 0, len(m.targets))
+	for name := range m.targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := make([]types.BreakerStatus, 0, len(names))
+	for _, name := range names {
+		if b := m.targets[name].breaker; b != nil {
+			statuses = append(statuses, b.status())
+		}
+	}
+	return append(statuses, m.addrs.statuses()...)
+}
+
+// Close stops the failover watchers and closes all target clients
+func (m *TargetManager) Close() {
+	m.cancel()
+	m.wg.Wait()
+	for _, t := range m.targets {
+		_ = t.primary.Close()
+		if t.replica != nil {
+			_ = t.replica.Close()
+		}
+		for _, node := range t.replicas {
+			_ = node.client.Close()
+		}
+	}
+}
+
+// Health pings every target and reports the slot topology of cluster targets and the shards of sharded targets
+func (m *TargetManager) Health(ctx context.Context) []types.TargetHealth {
+	names := make([]string, 0, len(m.targets))
+	for name := range m.targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := make([]types.TargetHealth, len(names))
+	for i, name := range names {
+		t := m.targets[name]
+		report[i] = types.TargetHealth{Name: name, Type: t.kind, Status: "up", Replicas: t.replicaHealth()}
+		if len(t.shards) > 0 {
+			report[i].Shards = t.shardHealth(ctx)
+			for _, shard := range report[i].Shards {
+				if shard.Status != "up" {
+					report[i].Status = "down"
+					report[i].Error = fmt.Sprintf("shard %s is down", shard.Name)
+					break
+				}
+			}
+			continue
+		}
+		if err := t.primary.Ping(ctx).Err(); err != nil {
+			report[i].Status = "down"
+			report[i].Error = err.Error()
+			continue
+		}
+		if cluster, ok := t.primary.(*redis.ClusterClient); ok {
+			topology, err := clusterTopology(ctx, cluster)
+			if err != nil {
+				report[i].Status = "down"
+				report[i].Error = err.Error()
+				continue
+			}
+			report[i].Topology = topology
+		}
+	}
+	return report
+}
+
+// clusterTopology converts CLUSTER SLOTS into slot ranges, the first node of each range is the master
+func clusterTopology(ctx context.Context, cluster *redis.ClusterClient) ([]types.ClusterSlotRange, error) {
+	slots, err := cluster.ClusterSlots(ctx).Result()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Start < slots[j].Start })
+
+	topology := make([]types.ClusterSlotRange, 0, len(slots))
+	for _, slot := range slots {
+		if len(slot.Nodes) == 0 {
+			continue
+		}
+		slotRange := types.ClusterSlotRange{
+			Start:    slot.Start,
+			End:      slot.End,
+			Master:   slot.Nodes[0].Addr,
+			Replicas: []string{},
+		}
+		for _, node := range slot.Nodes[1:] {
+			slotRange.Replicas = append(slotRange.Replicas, node.Addr)
+		}
+		topology = append(topology, slotRange)
+	}
+	return topology, nil
+}
+
+// watchFailover subscribes to the master switch events of a sentinel group,
+// moving on to the next sentinel whenever the current one becomes unreachable
+func (m *TargetManager) watchFailover(ctx context.Context, name string, cfg config.SentinelConfig, tlsConfig *tls.Config) {
+	defer m.wg.Done()
+
+	for i := 0; ctx.Err() == nil; i++ {
+		addr := cfg.Addrs[i%len(cfg.Addrs)]
+		sentinel := redis.NewSentinelClient(&redis.Options{
+			Addr:      addr,
+			Username:  cfg.Username,
+			Password:  cfg.Password,
+			TLSConfig: tlsConfig,
+		})
+		pubsub := sentinel.Subscribe(ctx, failoverChannel)
+		stop := context.AfterFunc(ctx, func() { _ = pubsub.Close() })
+
+		err := m.receiveFailovers(ctx, name, cfg.MasterName, pubsub)
+
+		stop()
+		_ = pubsub.Close()
+		_ = sentinel.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Debug("Sentinel subscription lost", logrus.Fields{
+			"target":   name,
+			"sentinel": addr,
+			"error":    err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(failoverRetryDelay):
+		}
+	}
+}
+
+// receiveFailovers handles switch events until the subscription fails
+func (m *TargetManager) receiveFailovers(ctx context.Context, name, masterName string, pubsub *redis.PubSub) error {
+	for {
+		msg, err := pubsub.ReceiveTimeout(ctx, failoverWatchInterval)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				if err := pubsub.Ping(ctx); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		message, ok := msg.(*redis.Message)
+		if !ok {
+			continue
+		}
+		// Payload: <master name> <old ip> <old port> <new ip> <new port>
+		fields := strings.Fields(message.Payload)
+		if len(fields) != 5 || fields[0] != masterName {
+			continue
+		}
+
+		logger.Warn("Redis sentinel failover", logrus.Fields{
+			"target": name,
+			"master": masterName,
+			"from":   net.JoinHostPort(fields[1], fields[2]),
+			"to":     net.JoinHostPort(fields[3], fields[4]),
+		})
+		metrics.Inc("redis_sentinel_failovers_total", metrics.Labels{"target": name})
+	}
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	redis "github.com/go-redis/redis/v8"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+)
+
+// newTLSConfig builds the client TLS settings of a Redis connection. An empty
+// server name is filled in from the address of each node when dialing.
+func newTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         cfg.ServerName,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // only accepted in dev mode, see config.TLSConfig
+	}
+	if cfg.MinVersion == config.TLSVersion13 {
+		tlsConfig.MinVersion = tls.VersionTLS13
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read tls ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load tls client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// targetTLSConfig returns the TLS settings of a target, nil when TLS is disabled
+func targetTLSConfig(cfg config.TargetConfig) (*tls.Config, error) {
+	if !cfg.TLS.Enabled {
+		return nil, nil
+	}
+	return newTLSConfig(cfg.TLS)
+}
+
+// clientNameHook names every new connection with CLIENT SETNAME so that the
+// proxy's sessions can be told apart in CLIENT LIST, nil when name is empty
+func clientNameHook(name string) func(ctx context.Context, cn *redis.Conn) error {
+	if name == "" {
+		return nil
+	}
+	return func(ctx context.Context, cn *redis.Conn) error {
+		return cn.ClientSetName(ctx, name).Err()
+	}
+}
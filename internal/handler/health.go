@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+// HealthHandler handles the health check of the configured Redis targets
+type HealthHandler struct {
+	healthService service.HealthService
+}
+
+// NewHealthHandler creates a new HealthHandler instance
+func NewHealthHandler(healthService service.HealthService) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+	}
+}
+
+// Health godoc
+// @Summary 健康检查
+// @Description 检查所有命名Redis目标的连通性，集群目标同时返回哈希槽分布
+// @Tags Health
+// @Produce json
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Router /health [get]
+func (h *HealthHandler) Health(c *gin.Context) {
+	data, err := h.healthService.Check(c.Request.Context())
+	response.JSON(c, data, err)
+}
+
+// Breakers godoc
+// @Summary 熔断器状态
+// @Description 返回每个命名Redis目标以及正在使用的直连地址的熔断器状态
+// @Tags Health
+// @Produce json
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Router /health/breakers [get]
+func (h *HealthHandler) Breakers(c *gin.Context) {
+	data, err := h.healthService.Breakers(c.Request.Context())
+	response.JSON(c, data, err)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+// ErrorCatalog godoc
+// @Summary 错误码目录
+// @Description 返回所有已注册的错误码及其模块、各语言消息、HTTP状态码和可重试性，以及各模块的号段
+// @Tags Meta
+// @Produce json,application/problem+json
+// @Success 200 {object} response.BaseResponse{data=errors.Catalog} "成功响应"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /meta/errors [get]
+func ErrorCatalog(c *gin.Context) {
+	catalog, err := errors.GetCatalog()
+	response.JSON(c, catalog, err)
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/pkg/metrics"
+)
+
+// Metrics godoc
+// @Summary Metrics endpoint
+// @Description 以Prometheus文本格式输出运行指标
+// @Tags Health
+// @Produce plain
+// @Success 200 {string} string "Prometheus指标"
+// @Router /metrics [get]
+func Metrics(c *gin.Context) {
+	var buf bytes.Buffer
+	if err := metrics.WritePrometheus(&buf); err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.Data(http.StatusOK, "text/plain; version=0.0.4; charset=utf-8", buf.Bytes())
+}
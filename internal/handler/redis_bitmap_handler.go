@@ -0,0 +1,362 @@
+package handler
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+var (
+	// bitFieldTypePattern matches signed (i1-i64) and unsigned (u1-u63) BITFIELD types
+	bitFieldTypePattern = regexp.MustCompile(`^[iu]([1-9][0-9]?)$`)
+	// bitFieldOffsetPattern matches plain bit offsets and #N type-width multiples
+	bitFieldOffsetPattern = regexp.MustCompile(`^#?[0-9]+$`)
+)
+
+// RedisBitmapHandler handles HTTP requests for Redis bitmap operations
+type RedisBitmapHandler struct {
+	bitmapService service.RedisBitmapService
+}
+
+// NewRedisBitmapHandler creates a new RedisBitmapHandler instance
+func NewRedisBitmapHandler(bitmapService service.RedisBitmapService) *RedisBitmapHandler {
+	return &RedisBitmapHandler{
+		bitmapService: bitmapService,
+	}
+}
+
+// RedisBitmapSetBit godoc
+// @Summary Redis位图SETBIT操作
+// @Description 设置位图指定偏移量上的位，返回原来的位值
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapSetBitRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/setbit [post]
+func (h *RedisBitmapHandler) RedisBitmapSetBit(c *gin.Context) {
+	var req types.BitmapSetBitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if req.Offset < 0 {
+		response.BadRequest(c, "Offset must not be negative", nil)
+		return
+	}
+	if req.Value != 0 && req.Value != 1 {
+		response.BadRequest(c, "Value must be 0 or 1", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.SetBit(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisBitmapGetBit godoc
+// @Summary Redis位图GETBIT操作
+// @Description 获取位图指定偏移量上的位
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapGetBitRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/getbit [post]
+func (h *RedisBitmapHandler) RedisBitmapGetBit(c *gin.Context) {
+	var req types.BitmapGetBitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if req.Offset < 0 {
+		response.BadRequest(c, "Offset must not be negative", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.GetBit(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisBitmapBitCount godoc
+// @Summary Redis位图BITCOUNT操作
+// @Description 统计位图中值为1的位数，可按BYTE或BIT指定范围
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapBitCountRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/bitcount [post]
+func (h *RedisBitmapHandler) RedisBitmapBitCount(c *gin.Context) {
+	var req types.BitmapBitCountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+
+	// Validate range
+	if (req.Start == nil) != (req.End == nil) {
+		response.BadRequest(c, "Start and end must be specified together", nil)
+		return
+	}
+	if msg := normalizeBitRange(req.Start, req.End, &req.Unit); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.BitCount(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisBitmapBitPos godoc
+// @Summary Redis位图BITPOS操作
+// @Description 查找位图中第一个值为0或1的位，可按BYTE或BIT指定范围
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapBitPosRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/bitpos [post]
+func (h *RedisBitmapHandler) RedisBitmapBitPos(c *gin.Context) {
+	var req types.BitmapBitPosRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if req.Bit != 0 && req.Bit != 1 {
+		response.BadRequest(c, "Bit must be 0 or 1", nil)
+		return
+	}
+
+	// Validate range
+	if req.End != nil && req.Start == nil {
+		response.BadRequest(c, "End requires start", nil)
+		return
+	}
+	if msg := normalizeBitRange(req.Start, req.End, &req.Unit); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.BitPos(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisBitmapBitOp godoc
+// @Summary Redis位图BITOP操作
+// @Description 对一个或多个位图执行AND、OR、XOR、NOT运算并保存到目标键
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapBitOpRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/bitop [post]
+func (h *RedisBitmapHandler) RedisBitmapBitOp(c *gin.Context) {
+	var req types.BitmapBitOpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	// Validate operation
+	req.Operation = strings.ToUpper(req.Operation)
+	switch req.Operation {
+	case "AND", "OR", "XOR":
+	case "NOT":
+		if len(req.Keys) != 1 {
+			response.BadRequest(c, "NOT takes exactly one key", nil)
+			return
+		}
+	default:
+		response.BadRequest(c, "Operation must be one of AND, OR, XOR, NOT", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.BitOp(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisBitmapBitField godoc
+// @Summary Redis位图BITFIELD操作
+// @Description 对位图执行按类型的GET、SET、INCRBY子操作，支持OVERFLOW WRAP/SAT/FAIL
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapBitFieldRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/bitfield [post]
+func (h *RedisBitmapHandler) RedisBitmapBitField(c *gin.Context) {
+	var req types.BitmapBitFieldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Operations) == 0 {
+		response.BadRequest(c, "Key and operations are required", nil)
+		return
+	}
+	if msg := normalizeBitFieldOperations(req.Operations, false); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.BitField(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisBitmapBitFieldRO godoc
+// @Summary Redis位图BITFIELD_RO操作
+// @Description 对位图执行只读的GET子操作（需要Redis 6.0+）
+// @Tags Redis Bitmap Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.BitmapBitFieldRORequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/bitmap/bitfield_ro [post]
+func (h *RedisBitmapHandler) RedisBitmapBitFieldRO(c *gin.Context) {
+	var req types.BitmapBitFieldRORequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Operations) == 0 {
+		response.BadRequest(c, "Key and operations are required", nil)
+		return
+	}
+	if msg := normalizeBitFieldOperations(req.Operations, true); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.bitmapService.BitFieldRO(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// normalizeBitRange upper-cases the range unit and checks it is only used with a full range
+func normalizeBitRange(start, end *int64, unit *string) string {
+	*unit = strings.ToUpper(*unit)
+	switch *unit {
+	case "":
+		return ""
+	case "BYTE", "BIT":
+	default:
+		return "Unit must be BYTE or BIT"
+	}
+	if start == nil || end == nil {
+		return "Unit requires start and end"
+	}
+	return ""
+}
+
+// normalizeBitFieldOperations canonicalizes BITFIELD sub-operations in place
+// and returns a validation message, or "" when all operations are valid
+func normalizeBitFieldOperations(operations []types.BitFieldOperation, readOnly bool) string {
+	for i := range operations {
+		op := &operations[i]
+		op.Op = strings.ToUpper(op.Op)
+
+		if op.Op == "OVERFLOW" {
+			if readOnly {
+				return "BITFIELD_RO only supports GET operations"
+			}
+			op.Overflow = strings.ToUpper(op.Overflow)
+			switch op.Overflow {
+			case "WRAP", "SAT", "FAIL":
+			default:
+				return "Overflow must be one of WRAP, SAT, FAIL"
+			}
+			continue
+		}
+
+		switch op.Op {
+		case "GET":
+		case "SET", "INCRBY":
+			if readOnly {
+				return "BITFIELD_RO only supports GET operations"
+			}
+		default:
+			return "Operation must be one of GET, SET, INCRBY, OVERFLOW"
+		}
+
+		op.Type = strings.ToLower(op.Type)
+		match := bitFieldTypePattern.FindStringSubmatch(op.Type)
+		if match == nil {
+			return "Type must be a signed (i1-i64) or unsigned (u1-u63) integer type"
+		}
+		bits, _ := strconv.Atoi(match[1])
+		if (op.Type[0] == 'i' && bits > 64) || (op.Type[0] == 'u' && bits > 63) {
+			return "Type must be a signed (i1-i64) or unsigned (u1-u63) integer type"
+		}
+		if !bitFieldOffsetPattern.MatchString(op.Offset) {
+			return "Offset must be a non-negative integer or #N"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisFunctionHandler handles HTTP requests for Redis Functions
+type RedisFunctionHandler struct {
+	functionService service.RedisFunctionService
+}
+
+// NewRedisFunctionHandler creates a new RedisFunctionHandler instance
+func NewRedisFunctionHandler(functionService service.RedisFunctionService) *RedisFunctionHandler {
+	return &RedisFunctionHandler{
+		functionService: functionService,
+	}
+}
+
+// RedisFunctionLoad godoc
+// @Summary Redis函数FUNCTION LOAD操作
+// @Description 加载函数库，replace为true时替换同名函数库（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionLoadRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/load [post]
+func (h *RedisFunctionHandler) RedisFunctionLoad(c *gin.Context) {
+	var req types.FunctionLoadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Code == "" {
+		response.BadRequest(c, "Code is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.Load(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisFunctionList godoc
+// @Summary Redis函数FUNCTION LIST操作
+// @Description 列出函数库及其函数，可按名称模式过滤（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionListRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/list [post]
+func (h *RedisFunctionHandler) RedisFunctionList(c *gin.Context) {
+	var req types.FunctionListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.List(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisFunctionDelete godoc
+// @Summary Redis函数FUNCTION DELETE操作
+// @Description 删除函数库（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionDeleteRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/delete [post]
+func (h *RedisFunctionHandler) RedisFunctionDelete(c *gin.Context) {
+	var req types.FunctionDeleteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.LibraryName == "" {
+		response.BadRequest(c, "Library name is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.Delete(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisFunctionDump godoc
+// @Summary Redis函数FUNCTION DUMP操作
+// @Description 导出所有函数库，返回base64编码的数据（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionDumpRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/dump [post]
+func (h *RedisFunctionHandler) RedisFunctionDump(c *gin.Context) {
+	var req types.FunctionDumpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.Dump(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisFunctionRestore godoc
+// @Summary Redis函数FUNCTION RESTORE操作
+// @Description 从FUNCTION DUMP数据恢复函数库，支持APPEND、REPLACE、FLUSH策略（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionRestoreRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/restore [post]
+func (h *RedisFunctionHandler) RedisFunctionRestore(c *gin.Context) {
+	var req types.FunctionRestoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate payload and policy
+	if _, err := base64.StdEncoding.DecodeString(req.Payload); req.Payload == "" || err != nil {
+		response.BadRequest(c, "Payload must be base64 encoded FUNCTION DUMP output", err)
+		return
+	}
+	req.Policy = strings.ToUpper(req.Policy)
+	switch req.Policy {
+	case "", "APPEND", "REPLACE", "FLUSH":
+	default:
+		response.BadRequest(c, "Policy must be one of APPEND, REPLACE, FLUSH", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.Restore(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisFunctionCall godoc
+// @Summary Redis函数FCALL操作
+// @Description 调用函数（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionCallRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/fcall [post]
+func (h *RedisFunctionHandler) RedisFunctionCall(c *gin.Context) {
+	var req types.FunctionCallRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Function == "" {
+		response.BadRequest(c, "Function is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.Call(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisFunctionCallRO godoc
+// @Summary Redis函数FCALL_RO操作
+// @Description 调用只读函数，配置了只读副本时发往副本（需要Redis 7.0+）
+// @Tags Redis Function Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.FunctionCallRORequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/function/fcall_ro [post]
+func (h *RedisFunctionHandler) RedisFunctionCallRO(c *gin.Context) {
+	var req types.FunctionCallRORequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Function == "" {
+		response.BadRequest(c, "Function is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.functionService.CallRO(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// Coordinate limits accepted by Redis geospatial indexes (EPSG:3857)
+const (
+	geoMaxLongitude = 180.0
+	geoMaxLatitude  = 85.05112878
+)
+
+// RedisGeoHandler handles HTTP requests for Redis geospatial operations
+type RedisGeoHandler struct {
+	geoService service.RedisGeoService
+}
+
+// NewRedisGeoHandler creates a new RedisGeoHandler instance
+func NewRedisGeoHandler(geoService service.RedisGeoService) *RedisGeoHandler {
+	return &RedisGeoHandler{
+		geoService: geoService,
+	}
+}
+
+// RedisGeoGeoAdd godoc
+// @Summary Redis地理位置GEOADD操作
+// @Description 向地理位置索引添加成员，支持NX、XX、CH标志（标志需要Redis 6.2+）
+// @Tags Redis Geo Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.GeoAddRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/geo/geoadd [post]
+func (h *RedisGeoHandler) RedisGeoGeoAdd(c *gin.Context) {
+	var req types.GeoAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Locations) == 0 {
+		response.BadRequest(c, "Key and locations are required", nil)
+		return
+	}
+	if req.NX && req.XX {
+		response.BadRequest(c, "NX and XX are mutually exclusive", nil)
+		return
+	}
+	for _, loc := range req.Locations {
+		if loc.Member == "" {
+			response.BadRequest(c, "Location member is required", nil)
+			return
+		}
+		if !validGeoCoordinates(loc.Longitude, loc.Latitude) {
+			response.BadRequest(c, "Longitude must be within ±180 and latitude within ±85.05112878", nil)
+			return
+		}
+	}
+
+	// Call service layer
+	data, err := h.geoService.GeoAdd(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisGeoGeoPos godoc
+// @Summary Redis地理位置GEOPOS操作
+// @Description 获取成员的经纬度坐标
+// @Tags Redis Geo Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.GeoPosRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/geo/geopos [post]
+func (h *RedisGeoHandler) RedisGeoGeoPos(c *gin.Context) {
+	var req types.GeoPosRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Members) == 0 {
+		response.BadRequest(c, "Key and members are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.geoService.GeoPos(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisGeoGeoDist godoc
+// @Summary Redis地理位置GEODIST操作
+// @Description 计算两个成员之间的距离
+// @Tags Redis Geo Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.GeoDistRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/geo/geodist [post]
+func (h *RedisGeoHandler) RedisGeoGeoDist(c *gin.Context) {
+	var req types.GeoDistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Member1 == "" || req.Member2 == "" {
+		response.BadRequest(c, "Key, member1 and member2 are required", nil)
+		return
+	}
+	if msg := normalizeGeoUnit(&req.Unit); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.geoService.GeoDist(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisGeoGeoHash godoc
+// @Summary Redis地理位置GEOHASH操作
+// @Description 获取成员的geohash字符串
+// @Tags Redis Geo Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.GeoHashRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/geo/geohash [post]
+func (h *RedisGeoHandler) RedisGeoGeoHash(c *gin.Context) {
+	var req types.GeoHashRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Members) == 0 {
+		response.BadRequest(c, "Key and members are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.geoService.GeoHash(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisGeoGeoSearch godoc
+// @Summary Redis地理位置GEOSEARCH操作
+// @Description 以成员或经纬度为中心，在圆形或矩形范围内搜索成员（需要Redis 6.2+）
+// @Tags Redis Geo Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.GeoSearchRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/geo/geosearch [post]
+func (h *RedisGeoHandler) RedisGeoGeoSearch(c *gin.Context) {
+	var req types.GeoSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if msg := normalizeGeoSearchOptions(&req.GeoSearchOptions); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.geoService.GeoSearch(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisGeoGeoSearchStore godoc
+// @Summary Redis地理位置GEOSEARCHSTORE操作
+// @Description 将范围搜索的结果保存到目标键（需要Redis 6.2+）
+// @Tags Redis Geo Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.GeoSearchStoreRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/geo/geosearchstore [post]
+func (h *RedisGeoHandler) RedisGeoGeoSearchStore(c *gin.Context) {
+	var req types.GeoSearchStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Destination == "" || req.Key == "" {
+		response.BadRequest(c, "Destination and key are required", nil)
+		return
+	}
+	if msg := normalizeGeoSearchOptions(&req.GeoSearchOptions); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.geoService.GeoSearchStore(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// validGeoCoordinates reports whether a point can be stored in a geospatial index
+func validGeoCoordinates(longitude, latitude float64) bool {
+	return longitude >= -geoMaxLongitude && longitude <= geoMaxLongitude &&
+		latitude >= -geoMaxLatitude && latitude <= geoMaxLatitude
+}
+
+// normalizeGeoUnit lower-cases the distance unit and defaults it to meters
+func normalizeGeoUnit(unit *string) string {
+	*unit = strings.ToLower(*unit)
+	switch *unit {
+	case "":
+		*unit = "m"
+	case "m", "km", "ft", "mi":
+	default:
+		return "Unit must be one of m, km, ft, mi"
+	}
+	return ""
+}
+
+// normalizeGeoSearchOptions checks that exactly one center and one shape are
+// given and canonicalizes unit and sort order
+func normalizeGeoSearchOptions(opt *types.GeoSearchOptions) string {
+	fromLonLat := opt.Longitude != nil || opt.Latitude != nil
+	if (opt.Member != "") == fromLonLat {
+		return "Specify either member or longitude and latitude"
+	}
+	if fromLonLat {
+		if opt.Longitude == nil || opt.Latitude == nil {
+			return "Longitude and latitude must be specified together"
+		}
+		if !validGeoCoordinates(*opt.Longitude, *opt.Latitude) {
+			return "Longitude must be within ±180 and latitude within ±85.05112878"
+		}
+	}
+
+	byBox := opt.Width != 0 || opt.Height != 0
+	if (opt.Radius != 0) == byBox {
+		return "Specify either radius or width and height"
+	}
+	if opt.Radius < 0 || (byBox && (opt.Width <= 0 || opt.Height <= 0)) {
+		return "Radius, width and height must be greater than 0"
+	}
+
+	if msg := normalizeGeoUnit(&opt.Unit); msg != "" {
+		return msg
+	}
+
+	opt.Sort = strings.ToUpper(opt.Sort)
+	if opt.Sort != "" && opt.Sort != "ASC" && opt.Sort != "DESC" {
+		return "Sort must be ASC or DESC"
+	}
+
+	if opt.Count < 0 {
+		return "Count must not be negative"
+	}
+	if opt.Any && opt.Count == 0 {
+		return "Any requires count"
+	}
+	return ""
+}
@@ -29,11 +29,12 @@ func NewRedisHandler(stringService service.RedisStringService, listService servi
 // @Description 根据指定的key获取Redis中存储的字符串值
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringGetRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/get [post]
 func (h *RedisHandler) RedisStringGet(c *gin.Context) {
 	var req types.StringGetRequest
@@ -53,16 +54,47 @@ func (h *RedisHandler) RedisStringGet(c *gin.Context) {
 	response.JSON(c, data, err)
 }
 
+// RedisStringMGet godoc
+// @Summary Redis字符串MGET操作
+// @Description 批量获取多个key的值，结果顺序与keys一致；集群目标按哈希槽拆分后合并
+// @Tags Redis String Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.StringMGetRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/string/mget [post]
+func (h *RedisHandler) RedisStringMGet(c *gin.Context) {
+	var req types.StringMGetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.stringService.MGet(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
 // RedisStringSet godoc
 // @Summary Redis字符串SET操作
 // @Description 设置指定key的字符串值，支持TTL过期时间
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringSetRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/set [post]
 func (h *RedisHandler) RedisStringSet(c *gin.Context) {
 	var req types.StringSetRequest
@@ -72,7 +104,7 @@ func (h *RedisHandler) RedisStringSet(c *gin.Context) {
 	}
 
 	// Validate required fields
-	if req.Key == "" || req.Value == "" {
+	if req.Key == "" || req.Value.IsEmpty() {
 		response.BadRequest(c, "Key and value are required", nil)
 		return
 	}
@@ -87,11 +119,12 @@ func (h *RedisHandler) RedisStringSet(c *gin.Context) {
 // @Description 删除指定的key
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringDelRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/del [post]
 func (h *RedisHandler) RedisStringDel(c *gin.Context) {
 	var req types.StringDelRequest
@@ -116,11 +149,12 @@ func (h *RedisHandler) RedisStringDel(c *gin.Context) {
 // @Description 检查指定key是否存在
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringExistsRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/exists [post]
 func (h *RedisHandler) RedisStringExists(c *gin.Context) {
 	var req types.StringExistsRequest
@@ -145,11 +179,12 @@ func (h *RedisHandler) RedisStringExists(c *gin.Context) {
 // @Description 将指定key的值加1
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringIncrRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/incr [post]
 func (h *RedisHandler) RedisStringIncr(c *gin.Context) {
 	var req types.StringIncrRequest
@@ -174,11 +209,12 @@ func (h *RedisHandler) RedisStringIncr(c *gin.Context) {
 // @Description 将指定key的值减1
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringDecrRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/decr [post]
 func (h *RedisHandler) RedisStringDecr(c *gin.Context) {
 	var req types.StringDecrRequest
@@ -203,11 +239,12 @@ func (h *RedisHandler) RedisStringDecr(c *gin.Context) {
 // @Description 为指定key设置过期时间
 // @Tags Redis String Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.StringExpireRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/string/expire [post]
 func (h *RedisHandler) RedisStringExpire(c *gin.Context) {
 	var req types.StringExpireRequest
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/ct-zh/go-redis-proxy/internal/service"
@@ -25,11 +27,12 @@ func NewRedisHashHandler(hashService service.RedisHashService) *RedisHashHandler
 // @Description 为哈希表中的字段赋值
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHSetRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hset [post]
 func (h *RedisHashHandler) RedisHashHSet(c *gin.Context) {
 	var req types.HashHSetRequest
@@ -54,11 +57,12 @@ func (h *RedisHashHandler) RedisHashHSet(c *gin.Context) {
 // @Description 获取哈希表中指定字段的值
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHGetRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hget [post]
 func (h *RedisHashHandler) RedisHashHGet(c *gin.Context) {
 	var req types.HashHGetRequest
@@ -83,11 +87,12 @@ func (h *RedisHashHandler) RedisHashHGet(c *gin.Context) {
 // @Description 获取哈希表中一个或多个字段的值
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHMGetRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hmget [post]
 func (h *RedisHashHandler) RedisHashHMGet(c *gin.Context) {
 	var req types.HashHMGetRequest
@@ -112,11 +117,12 @@ func (h *RedisHashHandler) RedisHashHMGet(c *gin.Context) {
 // @Description 获取哈希表中所有的字段和值
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHGetAllRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hgetall [post]
 func (h *RedisHashHandler) RedisHashHGetAll(c *gin.Context) {
 	var req types.HashHGetAllRequest
@@ -141,11 +147,12 @@ func (h *RedisHashHandler) RedisHashHGetAll(c *gin.Context) {
 // @Description 删除哈希表中一个或多个字段
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHDelRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hdel [post]
 func (h *RedisHashHandler) RedisHashHDel(c *gin.Context) {
 	var req types.HashHDelRequest
@@ -170,11 +177,12 @@ func (h *RedisHashHandler) RedisHashHDel(c *gin.Context) {
 // @Description 查看哈希表的指定字段是否存在
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHExistsRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hexists [post]
 func (h *RedisHashHandler) RedisHashHExists(c *gin.Context) {
 	var req types.HashHExistsRequest
@@ -199,11 +207,12 @@ func (h *RedisHashHandler) RedisHashHExists(c *gin.Context) {
 // @Description 获取哈希表中字段的数量
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHLenRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hlen [post]
 func (h *RedisHashHandler) RedisHashHLen(c *gin.Context) {
 	var req types.HashHLenRequest
@@ -228,11 +237,12 @@ func (h *RedisHashHandler) RedisHashHLen(c *gin.Context) {
 // @Description 获取哈希表中的所有字段名
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHKeysRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hkeys [post]
 func (h *RedisHashHandler) RedisHashHKeys(c *gin.Context) {
 	var req types.HashHKeysRequest
@@ -257,11 +267,12 @@ func (h *RedisHashHandler) RedisHashHKeys(c *gin.Context) {
 // @Description 获取哈希表中所有字段的值
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHValsRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hvals [post]
 func (h *RedisHashHandler) RedisHashHVals(c *gin.Context) {
 	var req types.HashHValsRequest
@@ -286,11 +297,12 @@ func (h *RedisHashHandler) RedisHashHVals(c *gin.Context) {
 // @Description 为哈希表中的字段值加上指定增量值
 // @Tags Redis Hash Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.HashHIncrByRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/hash/hincrby [post]
 func (h *RedisHashHandler) RedisHashHIncrBy(c *gin.Context) {
 	var req types.HashHIncrByRequest
@@ -308,4 +320,227 @@ func (h *RedisHashHandler) RedisHashHIncrBy(c *gin.Context) {
 	// Call service layer
 	data, err := h.hashService.HIncrBy(c.Request.Context(), &req)
 	response.JSON(c, data, err)
-}
\ No newline at end of file
+}
+
+// RedisHashHSetNX godoc
+// @Summary Redis哈希表HSETNX操作
+// @Description 仅当字段不存在时为哈希表中的字段赋值
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHSetNXRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/hsetnx [post]
+func (h *RedisHashHandler) RedisHashHSetNX(c *gin.Context) {
+	var req types.HashHSetNXRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Field == "" {
+		response.BadRequest(c, "Key and field are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HSetNX(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHashHIncrByFloat godoc
+// @Summary Redis哈希表HINCRBYFLOAT操作
+// @Description 为哈希表中指定字段的值加上浮点数增量
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHIncrByFloatRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/hincrbyfloat [post]
+func (h *RedisHashHandler) RedisHashHIncrByFloat(c *gin.Context) {
+	var req types.HashHIncrByFloatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Field == "" {
+		response.BadRequest(c, "Key and field are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HIncrByFloat(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHashHStrLen godoc
+// @Summary Redis哈希表HSTRLEN操作
+// @Description 获取哈希表中指定字段值的字符串长度
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHStrLenRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/hstrlen [post]
+func (h *RedisHashHandler) RedisHashHStrLen(c *gin.Context) {
+	var req types.HashHStrLenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Field == "" {
+		response.BadRequest(c, "Key and field are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HStrLen(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHashHRandField godoc
+// @Summary Redis哈希表HRANDFIELD操作
+// @Description 从哈希表中随机返回字段，可选返回字段值（需要Redis 6.2+）
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHRandFieldRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/hrandfield [post]
+func (h *RedisHashHandler) RedisHashHRandField(c *gin.Context) {
+	var req types.HashHRandFieldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HRandField(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHashHExpire godoc
+// @Summary Redis哈希表HEXPIRE操作
+// @Description 为哈希表中的字段设置过期时间（需要Redis 7.4+）
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHExpireRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/hexpire [post]
+func (h *RedisHashHandler) RedisHashHExpire(c *gin.Context) {
+	var req types.HashHExpireRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Fields) == 0 {
+		response.BadRequest(c, "Key and fields are required", nil)
+		return
+	}
+
+	// Validate TTL and condition
+	if req.TTL <= 0 {
+		response.BadRequest(c, "TTL must be greater than 0", nil)
+		return
+	}
+	req.Condition = strings.ToUpper(req.Condition)
+	switch req.Condition {
+	case "", "NX", "XX", "GT", "LT":
+	default:
+		response.BadRequest(c, "Condition must be one of NX, XX, GT, LT", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HExpire(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHashHTTL godoc
+// @Summary Redis哈希表HTTL操作
+// @Description 获取哈希表中字段的剩余过期时间（需要Redis 7.4+）
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHTTLRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/httl [post]
+func (h *RedisHashHandler) RedisHashHTTL(c *gin.Context) {
+	var req types.HashHTTLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Fields) == 0 {
+		response.BadRequest(c, "Key and fields are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HTTL(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHashHPersist godoc
+// @Summary Redis哈希表HPERSIST操作
+// @Description 移除哈希表中字段的过期时间（需要Redis 7.4+）
+// @Tags Redis Hash Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HashHPersistRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hash/hpersist [post]
+func (h *RedisHashHandler) RedisHashHPersist(c *gin.Context) {
+	var req types.HashHPersistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Fields) == 0 {
+		response.BadRequest(c, "Key and fields are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hashService.HPersist(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
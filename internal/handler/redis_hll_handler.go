@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisHLLHandler handles HTTP requests for Redis HyperLogLog operations
+type RedisHLLHandler struct {
+	hllService service.RedisHLLService
+}
+
+// NewRedisHLLHandler creates a new RedisHLLHandler instance
+func NewRedisHLLHandler(hllService service.RedisHLLService) *RedisHLLHandler {
+	return &RedisHLLHandler{
+		hllService: hllService,
+	}
+}
+
+// RedisHLLPFAdd godoc
+// @Summary Redis HyperLogLog PFADD操作
+// @Description 向HyperLogLog添加元素
+// @Tags Redis HyperLogLog Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HLLPFAddRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hll/pfadd [post]
+func (h *RedisHLLHandler) RedisHLLPFAdd(c *gin.Context) {
+	var req types.HLLPFAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hllService.PFAdd(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHLLPFCount godoc
+// @Summary Redis HyperLogLog PFCOUNT操作
+// @Description 返回一个或多个HyperLogLog并集的近似基数
+// @Tags Redis HyperLogLog Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HLLPFCountRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hll/pfcount [post]
+func (h *RedisHLLHandler) RedisHLLPFCount(c *gin.Context) {
+	var req types.HLLPFCountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hllService.PFCount(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisHLLPFMerge godoc
+// @Summary Redis HyperLogLog PFMERGE操作
+// @Description 将多个HyperLogLog合并到目标键
+// @Tags Redis HyperLogLog Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.HLLPFMergeRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/hll/pfmerge [post]
+func (h *RedisHLLHandler) RedisHLLPFMerge(c *gin.Context) {
+	var req types.HLLPFMergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.hllService.PFMerge(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
@@ -25,11 +25,12 @@ func NewRedisListHandler(listService service.RedisListService) *RedisListHandler
 // @Description 将一个或多个值插入到列表头部
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLPushRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/lpush [post]
 func (h *RedisListHandler) RedisListLPush(c *gin.Context) {
 	var req types.ListLPushRequest
@@ -54,11 +55,12 @@ func (h *RedisListHandler) RedisListLPush(c *gin.Context) {
 // @Description 将一个或多个值插入到列表尾部
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListRPushRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/rpush [post]
 func (h *RedisListHandler) RedisListRPush(c *gin.Context) {
 	var req types.ListRPushRequest
@@ -83,11 +85,12 @@ func (h *RedisListHandler) RedisListRPush(c *gin.Context) {
 // @Description 移出并获取列表的第一个元素
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLPopRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/lpop [post]
 func (h *RedisListHandler) RedisListLPop(c *gin.Context) {
 	var req types.ListLPopRequest
@@ -112,11 +115,12 @@ func (h *RedisListHandler) RedisListLPop(c *gin.Context) {
 // @Description 移出并获取列表的最后一个元素
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListRPopRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/rpop [post]
 func (h *RedisListHandler) RedisListRPop(c *gin.Context) {
 	var req types.ListRPopRequest
@@ -141,11 +145,12 @@ func (h *RedisListHandler) RedisListRPop(c *gin.Context) {
 // @Description 根据参数count的值，移除列表中与参数value相等的元素
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLRemRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/lrem [post]
 func (h *RedisListHandler) RedisListLRem(c *gin.Context) {
 	var req types.ListLRemRequest
@@ -170,11 +175,12 @@ func (h *RedisListHandler) RedisListLRem(c *gin.Context) {
 // @Description 通过索引获取列表中的元素
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLIndexRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/lindex [post]
 func (h *RedisListHandler) RedisListLIndex(c *gin.Context) {
 	var req types.ListLIndexRequest
@@ -199,11 +205,12 @@ func (h *RedisListHandler) RedisListLIndex(c *gin.Context) {
 // @Description 获取列表指定范围内的元素
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLRangeRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/lrange [post]
 func (h *RedisListHandler) RedisListLRange(c *gin.Context) {
 	var req types.ListLRangeRequest
@@ -228,11 +235,12 @@ func (h *RedisListHandler) RedisListLRange(c *gin.Context) {
 // @Description 获取列表长度
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLLenRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/llen [post]
 func (h *RedisListHandler) RedisListLLen(c *gin.Context) {
 	var req types.ListLLenRequest
@@ -257,11 +265,12 @@ func (h *RedisListHandler) RedisListLLen(c *gin.Context) {
 // @Description 对一个列表进行修剪，让列表只保留指定区间内的元素
 // @Tags Redis List Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ListLTrimRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/list/ltrim [post]
 func (h *RedisListHandler) RedisListLTrim(c *gin.Context) {
 	var req types.ListLTrimRequest
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// maxPipelineCommands bounds the number of commands in a single pipeline request
+const maxPipelineCommands = 1000
+
+// RedisPipelineHandler handles HTTP requests for Redis pipelines
+type RedisPipelineHandler struct {
+	pipelineService service.RedisPipelineService
+}
+
+// NewRedisPipelineHandler creates a new RedisPipelineHandler instance
+func NewRedisPipelineHandler(pipelineService service.RedisPipelineService) *RedisPipelineHandler {
+	return &RedisPipelineHandler{
+		pipelineService: pipelineService,
+	}
+}
+
+// RedisPipelineExec godoc
+// @Summary Redis Pipeline批量执行
+// @Description 通过Pipeline批量执行数据命令，结果顺序与commands一致，单个命令的Redis错误在对应结果的error中返回
+// @Tags Redis Pipeline Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.PipelineRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/pipeline [post]
+func (h *RedisPipelineHandler) RedisPipelineExec(c *gin.Context) {
+	var req types.PipelineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Commands) == 0 {
+		response.BadRequest(c, "Commands are required", nil)
+		return
+	}
+	if len(req.Commands) > maxPipelineCommands {
+		response.BadRequest(c, "Too many commands in pipeline", nil)
+		return
+	}
+	for _, command := range req.Commands {
+		if len(command) == 0 || command[0] == "" {
+			response.BadRequest(c, "Each command requires a name", nil)
+			return
+		}
+	}
+
+	// Call service layer
+	data, err := h.pipelineService.Exec(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
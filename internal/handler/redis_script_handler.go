@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// scriptSHAPattern matches a hex encoded SHA1 digest
+var scriptSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// RedisScriptHandler handles HTTP requests for Redis Lua scripting
+type RedisScriptHandler struct {
+	scriptService service.RedisScriptService
+}
+
+// NewRedisScriptHandler creates a new RedisScriptHandler instance
+func NewRedisScriptHandler(scriptService service.RedisScriptService) *RedisScriptHandler {
+	return &RedisScriptHandler{
+		scriptService: scriptService,
+	}
+}
+
+// RedisScriptEval godoc
+// @Summary Redis脚本EVAL操作
+// @Description 执行临时Lua脚本，read_only时使用EVAL_RO（需要配置SCRIPT_ENABLE_EVAL开启）
+// @Tags Redis Script Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ScriptEvalRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/script/eval [post]
+func (h *RedisScriptHandler) RedisScriptEval(c *gin.Context) {
+	var req types.ScriptEvalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Script == "" {
+		response.BadRequest(c, "Script is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.scriptService.Eval(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisScriptEvalSha godoc
+// @Summary Redis脚本EVALSHA操作
+// @Description 按SHA1执行已缓存的Lua脚本，read_only时使用EVALSHA_RO（需要配置SCRIPT_ENABLE_EVAL开启）
+// @Tags Redis Script Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ScriptEvalShaRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/script/evalsha [post]
+func (h *RedisScriptHandler) RedisScriptEvalSha(c *gin.Context) {
+	var req types.ScriptEvalShaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if !scriptSHAPattern.MatchString(req.SHA) {
+		response.BadRequest(c, "SHA must be a 40 character hex digest", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.scriptService.EvalSha(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisScriptRun godoc
+// @Summary Redis脚本命名脚本执行操作
+// @Description 按名称执行服务端注册的Lua脚本，自动处理SHA缓存与NOSCRIPT重试
+// @Tags Redis Script Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ScriptRunRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/script/run [post]
+func (h *RedisScriptHandler) RedisScriptRun(c *gin.Context) {
+	var req types.ScriptRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Name == "" {
+		response.BadRequest(c, "Name is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.scriptService.Run(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisScriptLoad godoc
+// @Summary Redis脚本SCRIPT LOAD操作
+// @Description 将Lua脚本加载到服务器脚本缓存（需要配置SCRIPT_ENABLE_EVAL开启）
+// @Tags Redis Script Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ScriptLoadRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/script/load [post]
+func (h *RedisScriptHandler) RedisScriptLoad(c *gin.Context) {
+	var req types.ScriptLoadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Script == "" {
+		response.BadRequest(c, "Script is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.scriptService.ScriptLoad(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisScriptExists godoc
+// @Summary Redis脚本SCRIPT EXISTS操作
+// @Description 检查脚本是否存在于服务器脚本缓存
+// @Tags Redis Script Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ScriptExistsRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/script/exists [post]
+func (h *RedisScriptHandler) RedisScriptExists(c *gin.Context) {
+	var req types.ScriptExistsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.SHAs) == 0 {
+		response.BadRequest(c, "SHAs are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.scriptService.ScriptExists(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisScriptFlush godoc
+// @Summary Redis脚本SCRIPT FLUSH操作
+// @Description 清空服务器脚本缓存（需要配置SCRIPT_ENABLE_EVAL开启）
+// @Tags Redis Script Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ScriptFlushRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/script/flush [post]
+func (h *RedisScriptHandler) RedisScriptFlush(c *gin.Context) {
+	var req types.ScriptFlushRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate mode
+	req.Mode = strings.ToUpper(req.Mode)
+	if req.Mode != "" && req.Mode != "ASYNC" && req.Mode != "SYNC" {
+		response.BadRequest(c, "Mode must be ASYNC or SYNC", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.scriptService.ScriptFlush(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisScriptList godoc
+// @Summary 命名脚本列表
+// @Description 列出服务端注册的命名脚本及其启用状态
+// @Tags Redis Script Operations
+// @Produce json
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Router /redis/script/list [get]
+func (h *RedisScriptHandler) RedisScriptList(c *gin.Context) {
+	data, err := h.scriptService.List(c.Request.Context())
+	response.JSON(c, data, err)
+}
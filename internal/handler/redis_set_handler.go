@@ -1,9 +1,8 @@
 package handler
 
 import (
-	"net/http"
-
 	"github.com/ct-zh/go-redis-proxy/internal/service"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
 	"github.com/ct-zh/go-redis-proxy/pkg/types"
 	"github.com/gin-gonic/gin"
 )
@@ -22,83 +21,322 @@ func NewRedisSetHandler(svc service.RedisSetService) *RedisSetHandler {
 func (h *RedisSetHandler) SAdd(c *gin.Context) {
 	var req types.RedisSAddRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.BadRequest(c, "Invalid request parameters", err)
 		return
 	}
 
 	count, err := h.svc.SAdd(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.JSON(c, nil, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"count": count})
+	response.Success(c, &types.SetCountData{Count: count})
 }
 
 // SRem handles the SREM command
 func (h *RedisSetHandler) SRem(c *gin.Context) {
 	var req types.RedisSRemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.BadRequest(c, "Invalid request parameters", err)
 		return
 	}
 
 	count, err := h.svc.SRem(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.JSON(c, nil, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"count": count})
+	response.Success(c, &types.SetCountData{Count: count})
 }
 
 // SIsMember handles the SISMEMBER command
 func (h *RedisSetHandler) SIsMember(c *gin.Context) {
 	var req types.RedisSIsMemberRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.BadRequest(c, "Invalid request parameters", err)
 		return
 	}
 
 	exists, err := h.svc.SIsMember(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.JSON(c, nil, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"exists": exists})
+	response.Success(c, &types.SetIsMemberData{Exists: exists})
 }
 
 // SMembers handles the SMEMBERS command
 func (h *RedisSetHandler) SMembers(c *gin.Context) {
 	var req types.RedisSMembersRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.BadRequest(c, "Invalid request parameters", err)
 		return
 	}
 
 	members, err := h.svc.SMembers(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.JSON(c, nil, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"members": members})
+	response.Success(c, &types.SetMembersData{Members: members})
 }
 
 // SCard handles the SCARD command
 func (h *RedisSetHandler) SCard(c *gin.Context) {
 	var req types.RedisSCardRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		response.BadRequest(c, "Invalid request parameters", err)
 		return
 	}
 
 	count, err := h.svc.SCard(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetCountData{Count: count})
+}
+
+// SInter handles the SINTER command
+func (h *RedisSetHandler) SInter(c *gin.Context) {
+	var req types.RedisSInterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	members, err := h.svc.SInter(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetMembersData{Members: members})
+}
+
+// SInterStore handles the SINTERSTORE command
+func (h *RedisSetHandler) SInterStore(c *gin.Context) {
+	var req types.RedisSInterStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	count, err := h.svc.SInterStore(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetCountData{Count: count})
+}
+
+// SInterCard handles the SINTERCARD command
+func (h *RedisSetHandler) SInterCard(c *gin.Context) {
+	var req types.RedisSInterCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+	if req.Limit < 0 {
+		response.BadRequest(c, "Limit must not be negative", nil)
+		return
+	}
+
+	count, err := h.svc.SInterCard(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetCountData{Count: count})
+}
+
+// SUnion handles the SUNION command
+func (h *RedisSetHandler) SUnion(c *gin.Context) {
+	var req types.RedisSUnionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	members, err := h.svc.SUnion(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetMembersData{Members: members})
+}
+
+// SUnionStore handles the SUNIONSTORE command
+func (h *RedisSetHandler) SUnionStore(c *gin.Context) {
+	var req types.RedisSUnionStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	count, err := h.svc.SUnionStore(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetCountData{Count: count})
+}
+
+// SDiff handles the SDIFF command
+func (h *RedisSetHandler) SDiff(c *gin.Context) {
+	var req types.RedisSDiffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	members, err := h.svc.SDiff(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetMembersData{Members: members})
+}
+
+// SDiffStore handles the SDIFFSTORE command
+func (h *RedisSetHandler) SDiffStore(c *gin.Context) {
+	var req types.RedisSDiffStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	count, err := h.svc.SDiffStore(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetCountData{Count: count})
+}
+
+// SMove handles the SMOVE command
+func (h *RedisSetHandler) SMove(c *gin.Context) {
+	var req types.RedisSMoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Source == "" || req.Destination == "" || req.Member == "" {
+		response.BadRequest(c, "Source, destination and member are required", nil)
+		return
+	}
+
+	moved, err := h.svc.SMove(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetMoveData{Moved: moved})
+}
+
+// SPop handles the SPOP command
+func (h *RedisSetHandler) SPop(c *gin.Context) {
+	var req types.RedisSPopRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if req.Count < 0 {
+		response.BadRequest(c, "Count must not be negative", nil)
+		return
+	}
+
+	members, err := h.svc.SPop(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetMembersData{Members: members})
+}
+
+// SRandMember handles the SRANDMEMBER command
+func (h *RedisSetHandler) SRandMember(c *gin.Context) {
+	var req types.RedisSRandMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+
+	members, err := h.svc.SRandMember(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
+		return
+	}
+
+	response.Success(c, &types.SetMembersData{Members: members})
+}
+
+// SMIsMember handles the SMISMEMBER command
+func (h *RedisSetHandler) SMIsMember(c *gin.Context) {
+	var req types.RedisSMIsMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+	if req.Key == "" || len(req.Members) == 0 {
+		response.BadRequest(c, "Key and members are required", nil)
+		return
+	}
+
+	exists, err := h.svc.SMIsMember(c.Request.Context(), &req)
+	if err != nil {
+		response.JSON(c, nil, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"count": count})
+	response.Success(c, &types.SetMIsMemberData{Exists: exists})
 }
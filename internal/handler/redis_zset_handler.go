@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/ct-zh/go-redis-proxy/internal/service"
@@ -25,11 +28,12 @@ func NewRedisZSetHandler(zsetService service.RedisZSetService) *RedisZSetHandler
 // @Description 将一个或多个成员元素及其分数值加入到有序集合中
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZAddRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zadd [post]
 func (h *RedisZSetHandler) RedisZSetZAdd(c *gin.Context) {
 	var req types.ZSetZAddRequest
@@ -39,11 +43,17 @@ func (h *RedisZSetHandler) RedisZSetZAdd(c *gin.Context) {
 	}
 
 	// Validate required fields
-	if req.Key == "" || len(req.Members) == 0 {
+	if req.Key == "" || len(req.Members)+len(req.ScoredMembers) == 0 {
 		response.BadRequest(c, "Key and members are required", nil)
 		return
 	}
 
+	// Validate flag combinations
+	if msg := validateZAddFlags(&req); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
 	// Call service layer
 	data, err := h.zsetService.ZAdd(c.Request.Context(), &req)
 	response.JSON(c, data, err)
@@ -54,11 +64,12 @@ func (h *RedisZSetHandler) RedisZSetZAdd(c *gin.Context) {
 // @Description 对有序集合中指定成员的分数加上增量increment
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZIncrByRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zincrby [post]
 func (h *RedisZSetHandler) RedisZSetZIncrBy(c *gin.Context) {
 	var req types.ZSetZIncrByRequest
@@ -83,11 +94,12 @@ func (h *RedisZSetHandler) RedisZSetZIncrBy(c *gin.Context) {
 // @Description 返回有序集合中指定成员的分数值
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZScoreRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zscore [post]
 func (h *RedisZSetHandler) RedisZSetZScore(c *gin.Context) {
 	var req types.ZSetZScoreRequest
@@ -112,11 +124,12 @@ func (h *RedisZSetHandler) RedisZSetZScore(c *gin.Context) {
 // @Description 获取有序集合的成员数
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZCardRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zcard [post]
 func (h *RedisZSetHandler) RedisZSetZCard(c *gin.Context) {
 	var req types.ZSetZCardRequest
@@ -141,11 +154,12 @@ func (h *RedisZSetHandler) RedisZSetZCard(c *gin.Context) {
 // @Description 计算在有序集合中指定区间分数的成员数
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZCountRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zcount [post]
 func (h *RedisZSetHandler) RedisZSetZCount(c *gin.Context) {
 	var req types.ZSetZCountRequest
@@ -170,11 +184,12 @@ func (h *RedisZSetHandler) RedisZSetZCount(c *gin.Context) {
 // @Description 返回有序集合中指定成员的排名，从0开始（分数从小到大）
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRankRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrank [post]
 func (h *RedisZSetHandler) RedisZSetZRank(c *gin.Context) {
 	var req types.ZSetZRankRequest
@@ -199,11 +214,12 @@ func (h *RedisZSetHandler) RedisZSetZRank(c *gin.Context) {
 // @Description 返回有序集合中指定成员的排名，从0开始（分数从大到小）
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRevRankRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrevrank [post]
 func (h *RedisZSetHandler) RedisZSetZRevRank(c *gin.Context) {
 	var req types.ZSetZRevRankRequest
@@ -228,11 +244,12 @@ func (h *RedisZSetHandler) RedisZSetZRevRank(c *gin.Context) {
 // @Description 通过索引区间返回有序集合成指定区间内的成员（分数从小到大）
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRangeRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrange [post]
 func (h *RedisZSetHandler) RedisZSetZRange(c *gin.Context) {
 	var req types.ZSetZRangeRequest
@@ -247,6 +264,24 @@ func (h *RedisZSetHandler) RedisZSetZRange(c *gin.Context) {
 		return
 	}
 
+	// Validate range modifiers
+	if req.ByScore && req.ByLex {
+		response.BadRequest(c, "by_score and by_lex are mutually exclusive", nil)
+		return
+	}
+	if (req.ByScore || req.ByLex) && (req.Min == "" || req.Max == "") {
+		response.BadRequest(c, "Min and max are required with by_score or by_lex", nil)
+		return
+	}
+	if req.Count > 0 && !req.ByScore && !req.ByLex {
+		response.BadRequest(c, "Offset and count require by_score or by_lex", nil)
+		return
+	}
+	if req.ByLex && req.WithScores {
+		response.BadRequest(c, "with_scores is not supported with by_lex", nil)
+		return
+	}
+
 	// Call service layer
 	data, err := h.zsetService.ZRange(c.Request.Context(), &req)
 	response.JSON(c, data, err)
@@ -257,11 +292,12 @@ func (h *RedisZSetHandler) RedisZSetZRange(c *gin.Context) {
 // @Description 通过索引区间返回有序集合成指定区间内的成员（分数从大到小）
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRevRangeRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrevrange [post]
 func (h *RedisZSetHandler) RedisZSetZRevRange(c *gin.Context) {
 	var req types.ZSetZRevRangeRequest
@@ -286,11 +322,12 @@ func (h *RedisZSetHandler) RedisZSetZRevRange(c *gin.Context) {
 // @Description 通过分数返回有序集合指定区间内的成员（分数从小到大）
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRangeByScoreRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrangebyscore [post]
 func (h *RedisZSetHandler) RedisZSetZRangeByScore(c *gin.Context) {
 	var req types.ZSetZRangeByScoreRequest
@@ -315,11 +352,12 @@ func (h *RedisZSetHandler) RedisZSetZRangeByScore(c *gin.Context) {
 // @Description 通过分数返回有序集合指定区间内的成员（分数从大到小）
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRevRangeByScoreRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrevrangebyscore [post]
 func (h *RedisZSetHandler) RedisZSetZRevRangeByScore(c *gin.Context) {
 	var req types.ZSetZRevRangeByScoreRequest
@@ -344,11 +382,12 @@ func (h *RedisZSetHandler) RedisZSetZRevRangeByScore(c *gin.Context) {
 // @Description 移除有序集合中的一个或多个成员
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRemRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zrem [post]
 func (h *RedisZSetHandler) RedisZSetZRem(c *gin.Context) {
 	var req types.ZSetZRemRequest
@@ -373,11 +412,12 @@ func (h *RedisZSetHandler) RedisZSetZRem(c *gin.Context) {
 // @Description 移除有序集合中给定的排名区间的所有成员
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRemRangeByRankRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zremrangebyrank [post]
 func (h *RedisZSetHandler) RedisZSetZRemRangeByRank(c *gin.Context) {
 	var req types.ZSetZRemRangeByRankRequest
@@ -402,11 +442,12 @@ func (h *RedisZSetHandler) RedisZSetZRemRangeByRank(c *gin.Context) {
 // @Description 移除有序集合中给定的分数区间的所有成员
 // @Tags Redis ZSet Operations
 // @Accept json
-// @Produce json
+// @Produce json,application/problem+json
 // @Param request body types.ZSetZRemRangeByScoreRequest true "请求参数"
 // @Success 200 {object} response.BaseResponse "成功响应"
 // @Failure 400 {object} response.BaseResponse "请求参数错误"
 // @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
 // @Router /redis/zset/zremrangebyscore [post]
 func (h *RedisZSetHandler) RedisZSetZRemRangeByScore(c *gin.Context) {
 	var req types.ZSetZRemRangeByScoreRequest
@@ -424,4 +465,493 @@ func (h *RedisZSetHandler) RedisZSetZRemRangeByScore(c *gin.Context) {
 	// Call service layer
 	data, err := h.zsetService.ZRemRangeByScore(c.Request.Context(), &req)
 	response.JSON(c, data, err)
-}
\ No newline at end of file
+}
+
+// RedisZSetZRangeByLex godoc
+// @Summary Redis有序集合ZRANGEBYLEX操作
+// @Description 通过字典区间返回有序集合的成员
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZRangeByLexRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zrangebylex [post]
+func (h *RedisZSetHandler) RedisZSetZRangeByLex(c *gin.Context) {
+	var req types.ZSetZRangeByLexRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Min == "" || req.Max == "" {
+		response.BadRequest(c, "Key, min and max are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZRangeByLex(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZLexCount godoc
+// @Summary Redis有序集合ZLEXCOUNT操作
+// @Description 计算有序集合中指定字典区间内成员数量
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZLexCountRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zlexcount [post]
+func (h *RedisZSetHandler) RedisZSetZLexCount(c *gin.Context) {
+	var req types.ZSetZLexCountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Min == "" || req.Max == "" {
+		response.BadRequest(c, "Key, min and max are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZLexCount(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZRemRangeByLex godoc
+// @Summary Redis有序集合ZREMRANGEBYLEX操作
+// @Description 移除有序集合中给定的字典区间的所有成员
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZRemRangeByLexRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zremrangebylex [post]
+func (h *RedisZSetHandler) RedisZSetZRemRangeByLex(c *gin.Context) {
+	var req types.ZSetZRemRangeByLexRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || req.Min == "" || req.Max == "" {
+		response.BadRequest(c, "Key, min and max are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZRemRangeByLex(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZPopMin godoc
+// @Summary Redis有序集合ZPOPMIN操作
+// @Description 移除并返回有序集合中分数最低的成员
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZPopMinRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zpopmin [post]
+func (h *RedisZSetHandler) RedisZSetZPopMin(c *gin.Context) {
+	var req types.ZSetZPopMinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if req.Count < 0 {
+		response.BadRequest(c, "Count must not be negative", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZPopMin(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZPopMax godoc
+// @Summary Redis有序集合ZPOPMAX操作
+// @Description 移除并返回有序集合中分数最高的成员
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZPopMaxRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zpopmax [post]
+func (h *RedisZSetHandler) RedisZSetZPopMax(c *gin.Context) {
+	var req types.ZSetZPopMaxRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+	if req.Count < 0 {
+		response.BadRequest(c, "Count must not be negative", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZPopMax(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetBZPopMin godoc
+// @Summary Redis有序集合BZPOPMIN操作
+// @Description 阻塞式移除并返回第一个非空有序集合中分数最低的成员。timeout必须大于0，且短于请求超时（timeout_ms或服务端request_timeout_ms），否则返回400
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetBZPopMinRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/bzpopmin [post]
+func (h *RedisZSetHandler) RedisZSetBZPopMin(c *gin.Context) {
+	var req types.ZSetBZPopMinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+	if req.Timeout <= 0 {
+		response.BadRequest(c, "Timeout must be greater than 0", nil)
+		return
+	}
+	// Blocking past the request deadline would always end in a timeout error
+	if deadline, ok := c.Request.Context().Deadline(); ok && time.Duration(req.Timeout*float64(time.Second)) >= time.Until(deadline) {
+		response.BadRequest(c, "Timeout must be shorter than the request timeout", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.BZPopMin(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZMScore godoc
+// @Summary Redis有序集合ZMSCORE操作
+// @Description 返回有序集合中多个成员的分数值，不存在的成员返回null
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZMScoreRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zmscore [post]
+func (h *RedisZSetHandler) RedisZSetZMScore(c *gin.Context) {
+	var req types.ZSetZMScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" || len(req.Members) == 0 {
+		response.BadRequest(c, "Key and members are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZMScore(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZRandMember godoc
+// @Summary Redis有序集合ZRANDMEMBER操作
+// @Description 从有序集合中随机返回成员，可选返回分数
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZRandMemberRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zrandmember [post]
+func (h *RedisZSetHandler) RedisZSetZRandMember(c *gin.Context) {
+	var req types.ZSetZRandMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Key == "" {
+		response.BadRequest(c, "Key is required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZRandMember(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZUnion godoc
+// @Summary Redis有序集合ZUNION操作
+// @Description 返回多个有序集合的并集，支持WEIGHTS和AGGREGATE
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZUnionRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zunion [post]
+func (h *RedisZSetHandler) RedisZSetZUnion(c *gin.Context) {
+	var req types.ZSetZUnionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	// Validate weights and aggregate
+	if msg := normalizeZStoreArgs(req.Keys, req.Weights, &req.Aggregate); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZUnion(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZUnionStore godoc
+// @Summary Redis有序集合ZUNIONSTORE操作
+// @Description 计算多个有序集合的并集并存储到目标key，支持WEIGHTS和AGGREGATE
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZUnionStoreRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zunionstore [post]
+func (h *RedisZSetHandler) RedisZSetZUnionStore(c *gin.Context) {
+	var req types.ZSetZUnionStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	// Validate weights and aggregate
+	if msg := normalizeZStoreArgs(req.Keys, req.Weights, &req.Aggregate); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZUnionStore(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZInter godoc
+// @Summary Redis有序集合ZINTER操作
+// @Description 返回多个有序集合的交集，支持WEIGHTS和AGGREGATE
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZInterRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zinter [post]
+func (h *RedisZSetHandler) RedisZSetZInter(c *gin.Context) {
+	var req types.ZSetZInterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	// Validate weights and aggregate
+	if msg := normalizeZStoreArgs(req.Keys, req.Weights, &req.Aggregate); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZInter(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZInterStore godoc
+// @Summary Redis有序集合ZINTERSTORE操作
+// @Description 计算多个有序集合的交集并存储到目标key，支持WEIGHTS和AGGREGATE
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZInterStoreRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zinterstore [post]
+func (h *RedisZSetHandler) RedisZSetZInterStore(c *gin.Context) {
+	var req types.ZSetZInterStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	// Validate weights and aggregate
+	if msg := normalizeZStoreArgs(req.Keys, req.Weights, &req.Aggregate); msg != "" {
+		response.BadRequest(c, msg, nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZInterStore(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZDiff godoc
+// @Summary Redis有序集合ZDIFF操作
+// @Description 返回第一个有序集合与其余有序集合的差集
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZDiffRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zdiff [post]
+func (h *RedisZSetHandler) RedisZSetZDiff(c *gin.Context) {
+	var req types.ZSetZDiffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Keys) == 0 {
+		response.BadRequest(c, "Keys are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZDiff(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// RedisZSetZDiffStore godoc
+// @Summary Redis有序集合ZDIFFSTORE操作
+// @Description 计算第一个有序集合与其余有序集合的差集并存储到目标key
+// @Tags Redis ZSet Operations
+// @Accept json
+// @Produce json,application/problem+json
+// @Param request body types.ZSetZDiffStoreRequest true "请求参数"
+// @Success 200 {object} response.BaseResponse "成功响应"
+// @Failure 400 {object} response.BaseResponse "请求参数错误"
+// @Failure 500 {object} response.BaseResponse "服务器内部错误"
+// @Failure default {object} response.Problem "错误详情（Accept为application/problem+json时）"
+// @Router /redis/zset/zdiffstore [post]
+func (h *RedisZSetHandler) RedisZSetZDiffStore(c *gin.Context) {
+	var req types.ZSetZDiffStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request parameters", err)
+		return
+	}
+
+	// Validate required fields
+	if req.Destination == "" || len(req.Keys) == 0 {
+		response.BadRequest(c, "Destination and keys are required", nil)
+		return
+	}
+
+	// Call service layer
+	data, err := h.zsetService.ZDiffStore(c.Request.Context(), &req)
+	response.JSON(c, data, err)
+}
+
+// validateZAddFlags checks the ZADD flag combinations rejected by Redis
+func validateZAddFlags(req *types.ZSetZAddRequest) string {
+	switch {
+	case req.NX && req.XX:
+		return "nx and xx are mutually exclusive"
+	case req.GT && req.LT:
+		return "gt and lt are mutually exclusive"
+	case req.NX && (req.GT || req.LT):
+		return "gt and lt cannot be combined with nx"
+	case req.Incr && len(req.Members)+len(req.ScoredMembers) != 1:
+		return "incr requires exactly one member"
+	}
+	return ""
+}
+
+// normalizeZStoreArgs validates WEIGHTS against the keys and upper-cases AGGREGATE
+func normalizeZStoreArgs(keys []string, weights []float64, aggregate *string) string {
+	if len(weights) > 0 && len(weights) != len(keys) {
+		return "The number of weights must match the number of keys"
+	}
+	*aggregate = strings.ToUpper(*aggregate)
+	switch *aggregate {
+	case "", "SUM", "MIN", "MAX":
+		return ""
+	}
+	return "Aggregate must be one of SUM, MIN, MAX"
+}
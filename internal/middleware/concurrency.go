@@ -0,0 +1,192 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/metrics"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+func init() {
+	metrics.Register("redis_target_inflight", "Requests currently executing against each Redis target.", metrics.KindGauge)
+	metrics.Register("redis_target_queue_depth", "Requests waiting for a concurrency slot of each Redis target.", metrics.KindGauge)
+	metrics.Register("redis_target_shed_total", "Requests rejected because a Redis target was overloaded.", metrics.KindCounter)
+}
+
+// 请求被拒绝的原因
+const (
+	shedQueueFull    = "queue_full"
+	shedQueueTimeout = "queue_timeout"
+	shedCanceled     = "canceled"
+)
+
+// ConcurrencyLimiter 限制每个Redis目标同时执行的请求数，超出时排队，队列满或等待超时的请求被拒绝
+type ConcurrencyLimiter struct {
+	defaults config.ConcurrencyConfig
+
+	mu         sync.Mutex
+	semaphores map[string]*semaphore
+}
+
+// semaphore 单个目标的并发槽位和等待队列
+type semaphore struct {
+	name     string
+	slots    chan struct{}
+	maxQueue int64
+	timeout  time.Duration
+	waiting  atomic.Int64
+
+	// users 持有或等待槽位的请求数，由ConcurrencyLimiter.mu保护，直连地址的semaphore在归零时删除
+	users int
+	named bool
+}
+
+// NewConcurrencyLimiter 为每个命名目标创建并发限制，直接指定addr的请求按地址使用默认限制
+func NewConcurrencyLimiter(redis config.RedisConfig, targets map[string]config.TargetConfig) *ConcurrencyLimiter {
+	l := &ConcurrencyLimiter{
+		defaults:   redis.Concurrency,
+		semaphores: make(map[string]*semaphore, len(targets)),
+	}
+	for name, target := range targets {
+		if sem := newSemaphore(name, target.Concurrency); sem != nil {
+			sem.named = true
+			l.semaphores[name] = sem
+		}
+	}
+	return l
+}
+
+// newSemaphore 创建目标的semaphore，未限制并发时返回nil
+func newSemaphore(name string, cfg config.ConcurrencyConfig) *semaphore {
+	if cfg.ConcurrentLimit() <= 0 {
+		return nil
+	}
+	return &semaphore{
+		name:     name,
+		slots:    make(chan struct{}, cfg.ConcurrentLimit()),
+		maxQueue: int64(cfg.QueueLimit()),
+		timeout:  time.Duration(cfg.QueueTimeoutMS) * time.Millisecond,
+	}
+}
+
+// Limit 返回并发限制中间件，目标过载时返回503
+func (l *ConcurrencyLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sem := l.get(requestTarget(c))
+		if sem == nil {
+			c.Next()
+			return
+		}
+		defer l.put(sem)
+
+		if reason := sem.acquire(c.Request.Context()); reason != "" {
+			metrics.Inc("redis_target_shed_total", metrics.Labels{"target": sem.name, "reason": reason})
+			logger.Warn("Redis target overloaded", logrus.Fields{
+				"target": sem.name,
+				"reason": reason,
+				"path":   c.Request.URL.Path,
+			})
+			c.Header("Retry-After", "1")
+			response.Abort(c, errors.NewError(errors.CodeRedisTargetOverloaded, strings.TrimPrefix(sem.name, addrTargetPrefix)))
+			return
+		}
+		defer sem.release()
+
+		c.Next()
+	}
+}
+
+// get 返回目标的semaphore并登记使用者，直连地址按需创建
+func (l *ConcurrencyLimiter) get(target string) *semaphore {
+	if target == "" {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sem, ok := l.semaphores[target]
+	if !ok {
+		if !strings.HasPrefix(target, addrTargetPrefix) {
+			// 未限制并发的命名目标或未知目标
+			return nil
+		}
+		sem = newSemaphore(target, l.defaults)
+		if sem == nil {
+			return nil
+		}
+		l.semaphores[target] = sem
+	}
+	sem.users++
+	return sem
+}
+
+// put 注销使用者，空闲的直连地址semaphore被删除，避免地址过多时占用内存
+func (l *ConcurrencyLimiter) put(sem *semaphore) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sem.users--
+	if sem.users == 0 && !sem.named {
+		delete(l.semaphores, sem.name)
+	}
+}
+
+// acquire 获取槽位，失败时返回拒绝原因
+func (s *semaphore) acquire(ctx context.Context) string {
+	select {
+	case s.slots <- struct{}{}:
+		s.reportInflight()
+		return ""
+	default:
+	}
+
+	if s.waiting.Add(1) > s.maxQueue {
+		s.waiting.Add(-1)
+		return shedQueueFull
+	}
+	s.reportQueue()
+	defer func() {
+		s.waiting.Add(-1)
+		s.reportQueue()
+	}()
+
+	timer := time.NewTimer(s.timeout)
+	defer timer.Stop()
+	select {
+	case s.slots <- struct{}{}:
+		s.reportInflight()
+		return ""
+	case <-timer.C:
+		return shedQueueTimeout
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			// 请求的整体超时先于排队超时到期
+			return shedQueueTimeout
+		}
+		return shedCanceled
+	}
+}
+
+// release 归还槽位
+func (s *semaphore) release() {
+	<-s.slots
+	s.reportInflight()
+}
+
+func (s *semaphore) reportInflight() {
+	metrics.Set("redis_target_inflight", metrics.Labels{"target": s.name}, float64(len(s.slots)))
+}
+
+func (s *semaphore) reportQueue() {
+	metrics.Set("redis_target_queue_depth", metrics.Labels{"target": s.name}, float64(s.waiting.Load()))
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+)
+
+// RequestDeadline 为请求的Redis操作设置整体超时，包括排队、连接、重试和执行命令的时间。
+// 请求可通过timeout_ms指定超时，但不超过服务端上限
+type RequestDeadline struct {
+	defaultTimeout time.Duration
+	maxTimeout     time.Duration
+}
+
+// NewRequestDeadline 根据Redis配置创建请求超时中间件
+func NewRequestDeadline(cfg config.RedisConfig) *RequestDeadline {
+	return &RequestDeadline{
+		defaultTimeout: time.Duration(cfg.RequestTimeoutMS) * time.Millisecond,
+		maxTimeout:     time.Duration(cfg.MaxRequestTimeoutMS) * time.Millisecond,
+	}
+}
+
+// Apply 返回请求超时中间件，超时后Redis操作返回错误码2001
+func (d *RequestDeadline) Apply() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := d.timeout(readRequestFields(c).timeoutMS)
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// timeout 返回请求的超时，未指定时使用默认值，为0表示不限制
+func (d *RequestDeadline) timeout(requestMS int) time.Duration {
+	if requestMS <= 0 {
+		return d.defaultTimeout
+	}
+	timeout := time.Duration(requestMS) * time.Millisecond
+	if timeout > d.maxTimeout {
+		timeout = d.maxTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+// ErrorFormat 为每个请求选择错误响应格式：BaseResponse或RFC 7807 problem+json
+type ErrorFormat struct {
+	problemDefault bool
+	typeBase       string
+}
+
+// NewErrorFormat 创建错误响应格式协商中间件
+func NewErrorFormat(server config.ServerConfig) *ErrorFormat {
+	return &ErrorFormat{
+		problemDefault: server.ErrorFormat == config.ErrorFormatProblem,
+		typeBase:       server.ProblemTypeBase,
+	}
+}
+
+// Negotiate 返回协商错误响应格式的中间件
+func (f *ErrorFormat) Negotiate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if f.wantsProblem(c.GetHeader("Accept")) {
+			response.UseProblemDetails(c, f.typeBase)
+		}
+		c.Next()
+	}
+}
+
+// wantsProblem 根据Accept请求头选择格式：application/problem+json与application/json中权重（q值）
+// 较高的一个，q=0表示不接受该类型；权重相同或都未指定（如*/*）时使用默认格式
+func (f *ErrorFormat) wantsProblem(accept string) bool {
+	var problem, envelope float64
+	var problemRejected, envelopeRejected bool
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		weight := 1.0
+		if q, ok := params["q"]; ok {
+			if weight, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case response.ProblemContentType:
+			problem = max(problem, weight)
+			problemRejected = problemRejected || weight == 0
+		case "application/json":
+			envelope = max(envelope, weight)
+			envelopeRejected = envelopeRejected || weight == 0
+		}
+	}
+
+	switch {
+	case problem > envelope:
+		return true
+	case envelope > problem:
+		return false
+	}
+	// 权重相同时使用默认格式，除非默认格式被q=0明确拒绝
+	if f.problemDefault {
+		return !problemRejected
+	}
+	return envelopeRejected && !problemRejected
+}
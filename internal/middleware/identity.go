@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"crypto/x509"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+// callerIdentityKey 调用方身份在gin.Context中的键
+const callerIdentityKey = "caller_identity"
+
+// aclAllGroups 允许访问全部路由组
+const aclAllGroups = "*"
+
+// IdentityMiddleware 从已校验的客户端证书中提取调用方身份
+func IdentityMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.TLS != nil && len(c.Request.TLS.VerifiedChains) > 0 {
+			if identity := certIdentity(c.Request.TLS.VerifiedChains[0][0]); identity != "" {
+				c.Set(callerIdentityKey, identity)
+			}
+		}
+		c.Next()
+	}
+}
+
+// certIdentity 依次使用证书的URI SAN（如SPIFFE ID）、DNS SAN、邮箱SAN和Subject CN作为身份
+func certIdentity(cert *x509.Certificate) string {
+	switch {
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String()
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0]
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0]
+	default:
+		return cert.Subject.CommonName
+	}
+}
+
+// CallerIdentity 返回客户端证书中的调用方身份，未提供证书时为空
+func CallerIdentity(c *gin.Context) string {
+	return c.GetString(callerIdentityKey)
+}
+
+// ACL 按调用方身份限制可访问的路由组
+type ACL struct {
+	identities map[string]map[string]bool
+	anonymous  map[string]bool
+	enabled    bool
+}
+
+// NewACL 根据配置创建访问控制，未配置时允许所有请求
+func NewACL(cfg config.ACLConfig) *ACL {
+	acl := &ACL{
+		identities: make(map[string]map[string]bool, len(cfg.Identities)),
+		anonymous:  groupSet(cfg.Anonymous),
+		enabled:    cfg.Enabled(),
+	}
+	for identity, groups := range cfg.Identities {
+		acl.identities[identity] = groupSet(groups)
+	}
+	return acl
+}
+
+func groupSet(groups []string) map[string]bool {
+	set := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		set[group] = true
+	}
+	return set
+}
+
+// Allow 返回检查路由组访问权限的中间件，无权访问时返回403
+func (a *ACL) Allow(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !a.enabled {
+			c.Next()
+			return
+		}
+
+		identity := CallerIdentity(c)
+		groups := a.anonymous
+		if identity != "" {
+			groups = a.identities[identity]
+		}
+		if !groups[group] && !groups[aclAllGroups] {
+			logger.Warn("Route group access denied", logrus.Fields{
+				"identity": identity,
+				"group":    group,
+				"path":     c.Request.URL.Path,
+			})
+			response.Abort(c, errors.NewError(errors.CodeForbidden, group))
+			return
+		}
+		c.Next()
+	}
+}
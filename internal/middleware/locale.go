@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+// Locale 为每个请求选择错误消息的语言：依次使用Accept-Language中支持的语言、
+// API Key的默认语言和配置的默认语言
+type Locale struct {
+	defaultLocale string
+	apiKeyHeader  string
+	apiKeyLocales map[string]string
+}
+
+// NewLocale 创建语言选择中间件，配置的语言必须有对应的消息目录
+func NewLocale(cfg config.I18nConfig) (*Locale, error) {
+	defaultLocale, ok := errors.MatchLocale(cfg.DefaultLocale)
+	if !ok {
+		return nil, fmt.Errorf("i18n default_locale %q has no message catalog", cfg.DefaultLocale)
+	}
+	apiKeyLocales := make(map[string]string, len(cfg.APIKeyLocales))
+	for key, tag := range cfg.APIKeyLocales {
+		locale, ok := errors.MatchLocale(tag)
+		if !ok {
+			return nil, fmt.Errorf("i18n api_key_locales: locale %q has no message catalog", tag)
+		}
+		apiKeyLocales[key] = locale
+	}
+	return &Locale{
+		defaultLocale: defaultLocale,
+		apiKeyHeader:  cfg.APIKeyHeader,
+		apiKeyLocales: apiKeyLocales,
+	}, nil
+}
+
+// Resolve 返回选择语言的中间件
+func (l *Locale) Resolve() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		response.SetLocale(c, l.resolve(c))
+		c.Next()
+	}
+}
+
+func (l *Locale) resolve(c *gin.Context) string {
+	if locale, ok := acceptLanguage(c.GetHeader("Accept-Language")); ok {
+		return locale
+	}
+	if key := c.GetHeader(l.apiKeyHeader); key != "" {
+		if locale, ok := l.apiKeyLocales[key]; ok {
+			return locale
+		}
+	}
+	return l.defaultLocale
+}
+
+// languageRange Accept-Language中的一项
+type languageRange struct {
+	tag    string
+	weight float64
+}
+
+// acceptLanguage 按权重从高到低返回Accept-Language中第一个支持的语言，忽略*和q=0的语言
+func acceptLanguage(header string) (string, bool) {
+	if header == "" {
+		return "", false
+	}
+
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if weight > 0 {
+			ranges = append(ranges, languageRange{tag: tag, weight: weight})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].weight > ranges[j].weight
+	})
+
+	for _, r := range ranges {
+		if locale, ok := errors.MatchLocale(r.tag); ok {
+			return locale, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// maxLoggedEncodedValue 日志中base64或hex编码的字符串最多保留的字符数
+const maxLoggedEncodedValue = 64
+
+// loggableBody 返回可以写入日志的请求体或响应体：JSON解析为对象，
+// 编码的二进制数据只保留前缀和长度，非UTF-8内容以base64记录，避免日志文件中出现二进制字节
+func loggableBody(body []byte, encoded bool) interface{} {
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		if encoded {
+			return truncateStrings(parsed)
+		}
+		return parsed
+	}
+	if utf8.Valid(body) {
+		return string(body)
+	}
+	return "base64:" + base64.StdEncoding.EncodeToString(body)
+}
+
+// bodyEncodings 返回请求体中的值和响应中的值是否使用base64或hex编码
+func bodyEncodings(body []byte) (request, response bool) {
+	var enc types.ValueEncoding
+	if json.Unmarshal(body, &enc) != nil {
+		return false, false
+	}
+	responseEncoding := enc.ResponseEncoding
+	if responseEncoding == "" {
+		responseEncoding = enc.Encoding
+	}
+	return isBinaryEncoding(enc.Encoding), isBinaryEncoding(responseEncoding)
+}
+
+// isBinaryEncoding 判断编码是否用于传输二进制数据
+func isBinaryEncoding(encoding string) bool {
+	return encoding == types.EncodingBase64 || encoding == types.EncodingHex
+}
+
+// truncateStrings 截断JSON中过长的字符串，保留前缀并注明原长度
+func truncateStrings(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		if runes := []rune(value); len(runes) > maxLoggedEncodedValue {
+			return fmt.Sprintf("%s...(%d chars)", string(runes[:maxLoggedEncodedValue]), len(runes))
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = truncateStrings(item)
+		}
+		return value
+	case map[string]interface{}:
+		truncated := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			// HGETALL等响应以编码后的字段为键
+			truncated[truncateStrings(key).(string)] = truncateStrings(item)
+		}
+		return truncated
+	}
+	return v
+}
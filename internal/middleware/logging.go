@@ -2,14 +2,15 @@ package middleware
 
 import (
 	"bytes"
-	"encoding/json"
 	"io"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
 	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
 )
 
 // responseWriter 包装gin的ResponseWriter以捕获响应数据
@@ -64,25 +65,28 @@ func LoggingMiddleware() gin.HandlerFunc {
 			"response_size": responseBuffer.Len(),
 		}
 
+		// 添加调用方身份（来自客户端证书）
+		if identity := CallerIdentity(c); identity != "" {
+			fields["caller"] = identity
+		}
+
+		// 添加处理过程中记录的底层错误（如Redis错误回复），不会返回给客户端
+		if len(c.Errors) > 0 {
+			fields["errors"] = c.Errors.Errors()
+		}
+
+		// 请求使用base64或hex传输二进制值时，日志中只保留编码值的前缀
+		requestEncoded, responseEncoded := bodyEncodings(requestBody)
+
 		// 添加请求体（如果是JSON格式且不为空）
 		if len(requestBody) > 0 && isJSONContent(c.Request.Header.Get("Content-Type")) {
-			var requestJSON interface{}
-			if err := json.Unmarshal(requestBody, &requestJSON); err == nil {
-				fields["request_body"] = requestJSON
-			} else {
-				fields["request_body"] = string(requestBody)
-			}
+			fields["request_body"] = loggableBody(requestBody, requestEncoded)
 		}
 
 		// 添加响应体（如果是JSON格式且不为空）
 		responseBody := responseBuffer.Bytes()
 		if len(responseBody) > 0 && isJSONContent(c.Writer.Header().Get("Content-Type")) {
-			var responseJSON interface{}
-			if err := json.Unmarshal(responseBody, &responseJSON); err == nil {
-				fields["response_body"] = responseJSON
-			} else {
-				fields["response_body"] = string(responseBody)
-			}
+			fields["response_body"] = loggableBody(responseBody, responseEncoded)
 		}
 
 		// 根据状态码决定日志级别
@@ -113,6 +117,7 @@ func LoggingMiddleware() gin.HandlerFunc {
 func isJSONContent(contentType string) bool {
 	return contentType == "application/json" || 
 		   contentType == "application/json; charset=utf-8" ||
+		   contentType == "application/problem+json" ||
 		   contentType == "text/json"
 }
 
@@ -160,12 +165,7 @@ func RecoveryMiddleware() gin.HandlerFunc {
 				logger.Error("Panic recovered", fields)
 				
 				// 返回500错误
-				c.JSON(500, gin.H{
-					"code": 500,
-					"msg":  "Internal Server Error",
-					"data": nil,
-				})
-				c.Abort()
+				response.Abort(c, errors.NewError(errors.CodeInternalError))
 			}
 		}()
 		c.Next()
@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/logger"
+	"github.com/ct-zh/go-redis-proxy/pkg/metrics"
+	"github.com/ct-zh/go-redis-proxy/pkg/ratelimit"
+	"github.com/ct-zh/go-redis-proxy/pkg/response"
+)
+
+func init() {
+	metrics.Register("http_rate_limited_total", "Number of requests rejected by each rate limit rule.", metrics.KindCounter)
+}
+
+// RateLimiter 令牌桶限流，按凭证、身份、IP、路由组和Redis目标的组合计数
+type RateLimiter struct {
+	store            ratelimit.Store
+	rules            []config.RateLimitRule
+	credentialHeader string
+}
+
+// NewRateLimiter 根据配置创建限流器，store为redis时令牌桶保存在指定的命名目标中
+func NewRateLimiter(cfg config.RateLimitConfig, targets *dao.TargetManager) (*RateLimiter, error) {
+	limiter := &RateLimiter{
+		rules:            cfg.Rules,
+		credentialHeader: cfg.CredentialHeader,
+	}
+	if cfg.Store == config.RateLimitStoreRedis {
+		client, err := targets.Client(cfg.StoreTarget, false)
+		if err != nil {
+			return nil, err
+		}
+		limiter.store = ratelimit.NewRedisStore(client, cfg.KeyPrefix)
+	} else {
+		limiter.store = ratelimit.NewMemoryStore()
+	}
+	return limiter, nil
+}
+
+// Limit 返回路由组的限流中间件，超出限制时返回429及Retry-After和RateLimit-*响应头
+func (l *RateLimiter) Limit(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(l.rules) == 0 {
+			c.Next()
+			return
+		}
+
+		req := &limitRequest{c: c, group: group, credentialHeader: l.credentialHeader}
+		var tightest *ratelimit.Result
+		var tightestBurst int
+		for _, rule := range l.rules {
+			if !ruleApplies(rule, group) {
+				continue
+			}
+			key, ok := req.key(rule)
+			if !ok {
+				continue
+			}
+
+			result, err := l.store.Take(c.Request.Context(), key, rule.Rate, rule.Burst)
+			if err != nil {
+				// 限流存储不可用时放行请求
+				logger.Warn("Rate limit store unavailable", logrus.Fields{
+					"rule":  rule.Name,
+					"error": err.Error(),
+				})
+				continue
+			}
+			if !result.Allowed {
+				retryAfter := int(math.Max(math.Ceil(result.RetryAfter.Seconds()), 1))
+				setRateLimitHeaders(c, rule.Burst, result)
+				c.Header("Retry-After", strconv.Itoa(retryAfter))
+				metrics.Inc("http_rate_limited_total", metrics.Labels{"rule": rule.Name, "group": group})
+				response.Abort(c, errors.NewError(errors.CodeRateLimited, retryAfter))
+				return
+			}
+			if tightest == nil || result.Remaining < tightest.Remaining {
+				r := result
+				tightest, tightestBurst = &r, rule.Burst
+			}
+		}
+
+		if tightest != nil {
+			setRateLimitHeaders(c, tightestBurst, *tightest)
+		}
+		c.Next()
+	}
+}
+
+// ruleApplies 判断规则是否适用于路由组
+func ruleApplies(rule config.RateLimitRule, group string) bool {
+	if len(rule.Groups) == 0 {
+		return true
+	}
+	for _, g := range rule.Groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// setRateLimitHeaders 设置RateLimit-*响应头，多条规则时取剩余令牌最少的规则
+func setRateLimitHeaders(c *gin.Context, burst int, result ratelimit.Result) {
+	c.Header("RateLimit-Limit", strconv.Itoa(burst))
+	c.Header("RateLimit-Remaining", strconv.Itoa(int(math.Floor(result.Remaining))))
+	c.Header("RateLimit-Reset", strconv.Itoa(int(math.Ceil(result.Reset.Seconds()))))
+}
+
+// limitRequest 计算请求在各限流维度上的取值
+type limitRequest struct {
+	c                *gin.Context
+	group            string
+	credentialHeader string
+}
+
+// key 返回规则的令牌桶键，任一维度取值为空时规则不适用
+func (r *limitRequest) key(rule config.RateLimitRule) (string, bool) {
+	parts := make([]string, 0, len(rule.By)+1)
+	parts = append(parts, rule.Name)
+	for _, by := range rule.By {
+		value := r.value(by)
+		if value == "" {
+			return "", false
+		}
+		parts = append(parts, by+"="+value)
+	}
+	return strings.Join(parts, ":"), true
+}
+
+func (r *limitRequest) value(by string) string {
+	switch by {
+	case config.RateLimitByCredential:
+		return r.c.GetHeader(r.credentialHeader)
+	case config.RateLimitByIdentity:
+		return CallerIdentity(r.c)
+	case config.RateLimitByIP:
+		return r.c.ClientIP()
+	case config.RateLimitByGroup:
+		return r.group
+	case config.RateLimitByTarget:
+		return requestTarget(r.c)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestFieldsKey 请求体中Redis相关字段在gin.Context中的键
+const requestFieldsKey = "request_fields"
+
+// requestFields 中间件关心的请求体字段
+type requestFields struct {
+	target    string // 命名目标为target，否则为"addr:"加地址
+	timeoutMS int    // 请求指定的timeout_ms
+}
+
+// requestTarget 返回请求的Redis目标：命名目标为target，否则为"addr:"加地址
+func requestTarget(c *gin.Context) string {
+	return readRequestFields(c).target
+}
+
+// readRequestFields 从请求体中读取中间件关心的字段。
+// 结果缓存在gin.Context中，读取后恢复请求体供handler使用
+func readRequestFields(c *gin.Context) requestFields {
+	if fields, ok := c.Get(requestFieldsKey); ok {
+		return fields.(requestFields)
+	}
+	fields := parseRequestFields(c)
+	c.Set(requestFieldsKey, fields)
+	return fields
+}
+
+func parseRequestFields(c *gin.Context) requestFields {
+	if c.Request.Body == nil {
+		return requestFields{}
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return requestFields{}
+	}
+
+	var req struct {
+		Target    string `json:"target"`
+		Addr      string `json:"addr"`
+		TimeoutMS int    `json:"timeout_ms"`
+	}
+	if json.Unmarshal(body, &req) != nil {
+		return requestFields{}
+	}
+	fields := requestFields{timeoutMS: req.TimeoutMS}
+	if req.Target != "" {
+		fields.target = req.Target
+	} else if req.Addr != "" {
+		fields.target = addrTargetPrefix + req.Addr
+	}
+	return fields
+}
+
+// addrTargetPrefix 区分直接指定addr的请求与命名目标
+const addrTargetPrefix = "addr:"
@@ -12,22 +12,35 @@ import (
 func SetupWithContainer(engine *gin.Engine, container *container.Container) {
 	// 添加全局中间件
 	engine.Use(middleware.RequestIDMiddleware())  // 请求ID中间件
+	engine.Use(container.ErrorFormat.Negotiate()) // 错误响应格式协商中间件
+	engine.Use(container.Locale.Resolve())        // 错误消息语言中间件
 	engine.Use(middleware.RecoveryMiddleware())   // 恢复中间件
 	engine.Use(middleware.LoggingMiddleware())    // 日志中间件
+	engine.Use(middleware.IdentityMiddleware())   // 调用方身份中间件
 
 	// Health check endpoint
 	engine.GET("/ping", handler.Ping)
+	engine.GET("/metrics", handler.Metrics)
+	engine.GET("/health", container.HealthHandler.Health)
+	engine.GET("/health/breakers", container.HealthHandler.Breakers)
 
 	// API v1 group
 	api := engine.Group("/api/v1")
 	{
+		// Metadata
+		meta := api.Group("/meta")
+		{
+			meta.GET("/errors", handler.ErrorCatalog)
+		}
+
 		// Redis operations
 		redis := api.Group("/redis")
 		{
 			// String operations
-			stringGroup := redis.Group("/string")
+			stringGroup := redis.Group("/string", container.ACL.Allow("string"), container.RateLimiter.Limit("string"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
 			{
 				stringGroup.POST("/get", container.RedisHandler.RedisStringGet)
+				stringGroup.POST("/mget", container.RedisHandler.RedisStringMGet)
 				stringGroup.POST("/set", container.RedisHandler.RedisStringSet)
 				stringGroup.POST("/del", container.RedisHandler.RedisStringDel)
 				stringGroup.POST("/exists", container.RedisHandler.RedisStringExists)
@@ -37,7 +50,7 @@ func SetupWithContainer(engine *gin.Engine, container *container.Container) {
 			}
 
 			// List operations
-			listGroup := redis.Group("/list")
+			listGroup := redis.Group("/list", container.ACL.Allow("list"), container.RateLimiter.Limit("list"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
 			{
 				listGroup.POST("/lpush", container.RedisListHandler.RedisListLPush)
 				listGroup.POST("/rpush", container.RedisListHandler.RedisListRPush)
@@ -51,17 +64,28 @@ func SetupWithContainer(engine *gin.Engine, container *container.Container) {
 			}
 
 			// Set operations
-			setGroup := redis.Group("/set")
+			setGroup := redis.Group("/set", container.ACL.Allow("set"), container.RateLimiter.Limit("set"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
 			{
 				setGroup.POST("/sadd", container.RedisSetHandler.SAdd)
 				setGroup.POST("/srem", container.RedisSetHandler.SRem)
 				setGroup.POST("/sismember", container.RedisSetHandler.SIsMember)
 				setGroup.POST("/smembers", container.RedisSetHandler.SMembers)
 				setGroup.POST("/scard", container.RedisSetHandler.SCard)
+				setGroup.POST("/sinter", container.RedisSetHandler.SInter)
+				setGroup.POST("/sinterstore", container.RedisSetHandler.SInterStore)
+				setGroup.POST("/sintercard", container.RedisSetHandler.SInterCard)
+				setGroup.POST("/sunion", container.RedisSetHandler.SUnion)
+				setGroup.POST("/sunionstore", container.RedisSetHandler.SUnionStore)
+				setGroup.POST("/sdiff", container.RedisSetHandler.SDiff)
+				setGroup.POST("/sdiffstore", container.RedisSetHandler.SDiffStore)
+				setGroup.POST("/smove", container.RedisSetHandler.SMove)
+				setGroup.POST("/spop", container.RedisSetHandler.SPop)
+				setGroup.POST("/srandmember", container.RedisSetHandler.SRandMember)
+				setGroup.POST("/smismember", container.RedisSetHandler.SMIsMember)
 			}
 
 			// ZSet operations
-			zsetGroup := redis.Group("/zset")
+			zsetGroup := redis.Group("/zset", container.ACL.Allow("zset"), container.RateLimiter.Limit("zset"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
 			{
 				zsetGroup.POST("/zadd", container.RedisZSetHandler.RedisZSetZAdd)
 				zsetGroup.POST("/zincrby", container.RedisZSetHandler.RedisZSetZIncrBy)
@@ -77,10 +101,24 @@ func SetupWithContainer(engine *gin.Engine, container *container.Container) {
 				zsetGroup.POST("/zrem", container.RedisZSetHandler.RedisZSetZRem)
 				zsetGroup.POST("/zremrangebyrank", container.RedisZSetHandler.RedisZSetZRemRangeByRank)
 				zsetGroup.POST("/zremrangebyscore", container.RedisZSetHandler.RedisZSetZRemRangeByScore)
+				zsetGroup.POST("/zrangebylex", container.RedisZSetHandler.RedisZSetZRangeByLex)
+				zsetGroup.POST("/zlexcount", container.RedisZSetHandler.RedisZSetZLexCount)
+				zsetGroup.POST("/zremrangebylex", container.RedisZSetHandler.RedisZSetZRemRangeByLex)
+				zsetGroup.POST("/zpopmin", container.RedisZSetHandler.RedisZSetZPopMin)
+				zsetGroup.POST("/zpopmax", container.RedisZSetHandler.RedisZSetZPopMax)
+				zsetGroup.POST("/bzpopmin", container.RedisZSetHandler.RedisZSetBZPopMin)
+				zsetGroup.POST("/zmscore", container.RedisZSetHandler.RedisZSetZMScore)
+				zsetGroup.POST("/zrandmember", container.RedisZSetHandler.RedisZSetZRandMember)
+				zsetGroup.POST("/zunion", container.RedisZSetHandler.RedisZSetZUnion)
+				zsetGroup.POST("/zunionstore", container.RedisZSetHandler.RedisZSetZUnionStore)
+				zsetGroup.POST("/zinter", container.RedisZSetHandler.RedisZSetZInter)
+				zsetGroup.POST("/zinterstore", container.RedisZSetHandler.RedisZSetZInterStore)
+				zsetGroup.POST("/zdiff", container.RedisZSetHandler.RedisZSetZDiff)
+				zsetGroup.POST("/zdiffstore", container.RedisZSetHandler.RedisZSetZDiffStore)
 			}
 
 			// Hash operations
-			hashGroup := redis.Group("/hash")
+			hashGroup := redis.Group("/hash", container.ACL.Allow("hash"), container.RateLimiter.Limit("hash"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
 			{
 				hashGroup.POST("/hset", container.RedisHashHandler.RedisHashHSet)
 				hashGroup.POST("/hget", container.RedisHashHandler.RedisHashHGet)
@@ -92,7 +130,72 @@ func SetupWithContainer(engine *gin.Engine, container *container.Container) {
 				hashGroup.POST("/hkeys", container.RedisHashHandler.RedisHashHKeys)
 				hashGroup.POST("/hvals", container.RedisHashHandler.RedisHashHVals)
 				hashGroup.POST("/hincrby", container.RedisHashHandler.RedisHashHIncrBy)
+				hashGroup.POST("/hsetnx", container.RedisHashHandler.RedisHashHSetNX)
+				hashGroup.POST("/hincrbyfloat", container.RedisHashHandler.RedisHashHIncrByFloat)
+				hashGroup.POST("/hstrlen", container.RedisHashHandler.RedisHashHStrLen)
+				hashGroup.POST("/hrandfield", container.RedisHashHandler.RedisHashHRandField)
+				hashGroup.POST("/hexpire", container.RedisHashHandler.RedisHashHExpire)
+				hashGroup.POST("/httl", container.RedisHashHandler.RedisHashHTTL)
+				hashGroup.POST("/hpersist", container.RedisHashHandler.RedisHashHPersist)
+			}
+
+			// Bitmap operations
+			bitmapGroup := redis.Group("/bitmap", container.ACL.Allow("bitmap"), container.RateLimiter.Limit("bitmap"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
+			{
+				bitmapGroup.POST("/setbit", container.RedisBitmapHandler.RedisBitmapSetBit)
+				bitmapGroup.POST("/getbit", container.RedisBitmapHandler.RedisBitmapGetBit)
+				bitmapGroup.POST("/bitcount", container.RedisBitmapHandler.RedisBitmapBitCount)
+				bitmapGroup.POST("/bitpos", container.RedisBitmapHandler.RedisBitmapBitPos)
+				bitmapGroup.POST("/bitop", container.RedisBitmapHandler.RedisBitmapBitOp)
+				bitmapGroup.POST("/bitfield", container.RedisBitmapHandler.RedisBitmapBitField)
+				bitmapGroup.POST("/bitfield_ro", container.RedisBitmapHandler.RedisBitmapBitFieldRO)
 			}
+
+			// HyperLogLog operations
+			hllGroup := redis.Group("/hll", container.ACL.Allow("hll"), container.RateLimiter.Limit("hll"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
+			{
+				hllGroup.POST("/pfadd", container.RedisHLLHandler.RedisHLLPFAdd)
+				hllGroup.POST("/pfcount", container.RedisHLLHandler.RedisHLLPFCount)
+				hllGroup.POST("/pfmerge", container.RedisHLLHandler.RedisHLLPFMerge)
+			}
+
+			// Geo operations
+			geoGroup := redis.Group("/geo", container.ACL.Allow("geo"), container.RateLimiter.Limit("geo"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
+			{
+				geoGroup.POST("/geoadd", container.RedisGeoHandler.RedisGeoGeoAdd)
+				geoGroup.POST("/geopos", container.RedisGeoHandler.RedisGeoGeoPos)
+				geoGroup.POST("/geodist", container.RedisGeoHandler.RedisGeoGeoDist)
+				geoGroup.POST("/geohash", container.RedisGeoHandler.RedisGeoGeoHash)
+				geoGroup.POST("/geosearch", container.RedisGeoHandler.RedisGeoGeoSearch)
+				geoGroup.POST("/geosearchstore", container.RedisGeoHandler.RedisGeoGeoSearchStore)
+			}
+
+			// Script operations
+			scriptGroup := redis.Group("/script", container.ACL.Allow("script"), container.RateLimiter.Limit("script"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
+			{
+				scriptGroup.POST("/eval", container.RedisScriptHandler.RedisScriptEval)
+				scriptGroup.POST("/evalsha", container.RedisScriptHandler.RedisScriptEvalSha)
+				scriptGroup.POST("/run", container.RedisScriptHandler.RedisScriptRun)
+				scriptGroup.POST("/load", container.RedisScriptHandler.RedisScriptLoad)
+				scriptGroup.POST("/exists", container.RedisScriptHandler.RedisScriptExists)
+				scriptGroup.POST("/flush", container.RedisScriptHandler.RedisScriptFlush)
+				scriptGroup.GET("/list", container.RedisScriptHandler.RedisScriptList)
+			}
+
+			// Function operations
+			functionGroup := redis.Group("/function", container.ACL.Allow("function"), container.RateLimiter.Limit("function"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit())
+			{
+				functionGroup.POST("/load", container.RedisFunctionHandler.RedisFunctionLoad)
+				functionGroup.POST("/list", container.RedisFunctionHandler.RedisFunctionList)
+				functionGroup.POST("/delete", container.RedisFunctionHandler.RedisFunctionDelete)
+				functionGroup.POST("/dump", container.RedisFunctionHandler.RedisFunctionDump)
+				functionGroup.POST("/restore", container.RedisFunctionHandler.RedisFunctionRestore)
+				functionGroup.POST("/fcall", container.RedisFunctionHandler.RedisFunctionCall)
+				functionGroup.POST("/fcall_ro", container.RedisFunctionHandler.RedisFunctionCallRO)
+			}
+
+			// Pipeline operations
+			redis.POST("/pipeline", container.ACL.Allow("pipeline"), container.RateLimiter.Limit("pipeline"), container.RequestDeadline.Apply(), container.ConcurrencyLimiter.Limit(), container.RedisPipelineHandler.RedisPipelineExec)
 		}
 	}
 }
\ No newline at end of file
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+)
+
+// commandMinVersions lists the first Redis version supporting commands, or
+// command options, that older servers still in use may lack
+var commandMinVersions = map[string]string{
+	"HRANDFIELD":               "6.2.0",
+	"HEXPIRE":                  "7.4.0",
+	"HTTL":                     "7.4.0",
+	"HPERSIST":                 "7.4.0",
+	"BITFIELD_RO":              "6.0.0",
+	"BITCOUNT BIT":             "7.0.0",
+	"BITPOS BIT":               "7.0.0",
+	"GEOADD NX/XX/CH":          "6.2.0",
+	"GEOSEARCH":                "6.2.0",
+	"GEOSEARCHSTORE":           "6.2.0",
+	"ZMSCORE":                  "6.2.0",
+	"ZRANDMEMBER":              "6.2.0",
+	"ZUNION":                   "6.2.0",
+	"ZINTER":                   "6.2.0",
+	"ZDIFF":                    "6.2.0",
+	"ZDIFFSTORE":               "6.2.0",
+	"ZRANGE BYSCORE/BYLEX/REV": "6.2.0",
+	"SMISMEMBER":               "6.2.0",
+	"SINTERCARD":               "7.0.0",
+	"EVAL_RO":                  "7.0.0",
+	"EVALSHA_RO":               "7.0.0",
+	"SCRIPT FLUSH ASYNC/SYNC":  "6.2.0",
+	"FUNCTION":                 "7.0.0",
+	"FCALL":                    "7.0.0",
+	"FCALL_RO":                 "7.0.0",
+}
+
+// checkCommandSupported returns CodeRedisCommandUnsupported when the connected
+// server is older than the version that introduced the command.
+// If the version cannot be determined the command is attempted anyway.
+func checkCommandSupported(ctx context.Context, conn dao.RedisConn, command string) error {
+	required, ok := commandMinVersions[command]
+	if !ok {
+		return nil
+	}
+
+	version, err := conn.ServerVersion(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if !versionAtLeast(version, required) {
+		return errors.NewError(errors.CodeRedisCommandUnsupported, command, version, required)
+	}
+	return nil
+}
+
+// versionAtLeast compares dotted version strings such as "7.4.0"
+func versionAtLeast(version, required string) bool {
+	have := strings.Split(version, ".")
+	want := strings.Split(required, ".")
+	for i := range want {
+		var h, w int
+		if i < len(have) {
+			h, _ = strconv.Atoi(have[i])
+		}
+		w, _ = strconv.Atoi(want[i])
+		if h != w {
+			return h > w
+		}
+	}
+	return true
+}
+
+// checkColocated returns CodeClusterCrossSlot when an atomic multi-key command
+// addresses keys in different hash slots of a cluster target or on different
+// nodes of a sharded target. Such commands cannot be split without losing
+// atomicity, so they are rejected up front.
+func checkColocated(conn dao.RedisConn, command string, keys ...string) error {
+	if !conn.Colocated(keys...) {
+		return errors.NewError(errors.CodeClusterCrossSlot, command)
+	}
+	return nil
+}
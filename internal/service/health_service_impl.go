@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// healthCheckTimeout bounds the time spent checking all targets
+const healthCheckTimeout = 3 * time.Second
+
+// HealthServiceImpl implements the HealthService interface
+type HealthServiceImpl struct {
+	targets *dao.TargetManager
+}
+
+// NewHealthService creates a new HealthServiceImpl instance
+func NewHealthService(targets *dao.TargetManager) HealthService {
+	return &HealthServiceImpl{
+		targets: targets,
+	}
+}
+
+// Check reports the state of every named target, including the slot topology of cluster targets and the shards of sharded targets
+func (s *HealthServiceImpl) Check(ctx context.Context) (*types.HealthData, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	data := &types.HealthData{
+		Status:  "ok",
+		Targets: s.targets.Health(ctx),
+	}
+	for _, target := range data.Targets {
+		if target.Status != "up" {
+			data.Status = "degraded"
+			break
+		}
+	}
+	return data, nil
+}
+
+// Breakers reports the circuit breaker state of every named target and of the request addrs in use
+func (s *HealthServiceImpl) Breakers(ctx context.Context) (*types.BreakerData, error) {
+	return &types.BreakerData{
+		Breakers: s.targets.Breakers(),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonPathSegment is one step of a json path, either an object member or an array index
+type jsonPathSegment struct {
+	name  string
+	index int
+	isKey bool
+}
+
+// canonicalJSON validates a JSON document and returns its compact form with sorted object keys,
+// numbers keep their original precision
+func canonicalJSON(raw []byte) (string, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return "", fmt.Errorf("empty document")
+	}
+	doc, err := parseJSONDocument(string(raw))
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// parseJSONDocument parses a single JSON document, numbers are kept as json.Number
+func parseJSONDocument(raw string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the document")
+	}
+	return doc, nil
+}
+
+// parseJSONPath parses a path such as $.user.tags[0] or $["first name"], the leading $ is optional
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	rest := strings.TrimPrefix(path, "$")
+	var segments []jsonPathSegment
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty member name")
+			}
+			segments = append(segments, jsonPathSegment{name: rest[:end], isKey: true})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ]")
+			}
+			inner := rest[1:end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+				segments = append(segments, jsonPathSegment{name: inner[1 : len(inner)-1], isKey: true})
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid array index %q", inner)
+				}
+				segments = append(segments, jsonPathSegment{index: index})
+			}
+			rest = rest[end+1:]
+		default:
+			if len(segments) == 0 && rest == path {
+				// A bare member name without the leading $
+				rest = "." + rest
+				continue
+			}
+			return nil, fmt.Errorf("unexpected %q", rest[0])
+		}
+	}
+	return segments, nil
+}
+
+// selectJSONPath returns the part of a document selected by the path, nil when it does not exist
+func selectJSONPath(doc interface{}, path []jsonPathSegment) interface{} {
+	for _, segment := range path {
+		if segment.isKey {
+			object, ok := doc.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			doc = object[segment.name]
+			continue
+		}
+		array, ok := doc.([]interface{})
+		if !ok || segment.index >= len(array) {
+			return nil
+		}
+		doc = array[segment.index]
+	}
+	return doc
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisBitmapServiceImpl implements the RedisBitmapService interface
+type RedisBitmapServiceImpl struct {
+	redisDAO dao.RedisDAO
+}
+
+// NewRedisBitmapService creates a new RedisBitmapServiceImpl instance
+func NewRedisBitmapService(redisDAO dao.RedisDAO) RedisBitmapService {
+	return &RedisBitmapServiceImpl{
+		redisDAO: redisDAO,
+	}
+}
+
+// SetBit sets the bit at offset and returns its previous value
+func (s *RedisBitmapServiceImpl) SetBit(ctx context.Context, req *types.BitmapSetBitRequest) (*types.BitmapSetBitData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	previous, err := conn.BitmapSetBit(ctx, req.Key, req.Offset, req.Value)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapSetFailed)
+	}
+
+	return &types.BitmapSetBitData{Previous: previous}, nil
+}
+
+// GetBit gets the bit at offset
+func (s *RedisBitmapServiceImpl) GetBit(ctx context.Context, req *types.BitmapGetBitRequest) (*types.BitmapGetBitData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	value, err := conn.BitmapGetBit(ctx, req.Key, req.Offset)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapGetFailed)
+	}
+
+	return &types.BitmapGetBitData{Value: value}, nil
+}
+
+// BitCount counts the set bits of a key, optionally within a range
+func (s *RedisBitmapServiceImpl) BitCount(ctx context.Context, req *types.BitmapBitCountRequest) (*types.BitmapBitCountData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.Unit == "BIT" {
+		if err := checkCommandSupported(ctx, conn, "BITCOUNT BIT"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call DAO layer
+	count, err := conn.BitmapBitCount(ctx, req.Key, req.Start, req.End, req.Unit)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapQueryFailed)
+	}
+
+	return &types.BitmapBitCountData{Count: count}, nil
+}
+
+// BitPos finds the first bit set to the requested value
+func (s *RedisBitmapServiceImpl) BitPos(ctx context.Context, req *types.BitmapBitPosRequest) (*types.BitmapBitPosData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.Unit == "BIT" {
+		if err := checkCommandSupported(ctx, conn, "BITPOS BIT"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call DAO layer
+	position, err := conn.BitmapBitPos(ctx, req.Key, int64(req.Bit), req.Start, req.End, req.Unit)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapQueryFailed)
+	}
+
+	return &types.BitmapBitPosData{Position: position}, nil
+}
+
+// BitOp performs a bitwise operation between keys and stores the result
+func (s *RedisBitmapServiceImpl) BitOp(ctx context.Context, req *types.BitmapBitOpRequest) (*types.BitmapBitOpData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "BITOP", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	length, err := conn.BitmapBitOp(ctx, req.Operation, req.Destination, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapOpFailed)
+	}
+
+	return &types.BitmapBitOpData{Length: length}, nil
+}
+
+// BitField runs a sequence of typed GET/SET/INCRBY/OVERFLOW sub-operations
+func (s *RedisBitmapServiceImpl) BitField(ctx context.Context, req *types.BitmapBitFieldRequest) (*types.BitmapBitFieldData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	replies, err := conn.BitmapBitField(ctx, req.Key, false, req.Operations)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapFieldFailed)
+	}
+
+	return &types.BitmapBitFieldData{Results: bitFieldResults(req.Operations, replies)}, nil
+}
+
+// BitFieldRO runs read-only GET sub-operations, allowed on replicas
+func (s *RedisBitmapServiceImpl) BitFieldRO(ctx context.Context, req *types.BitmapBitFieldRORequest) (*types.BitmapBitFieldData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "BITFIELD_RO"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	replies, err := conn.BitmapBitField(ctx, req.Key, true, req.Operations)
+	if err != nil {
+		return nil, redisError(err, errors.CodeBitmapFieldFailed)
+	}
+
+	return &types.BitmapBitFieldData{Results: bitFieldResults(req.Operations, replies)}, nil
+}
+
+// bitFieldResults pairs each non-OVERFLOW sub-operation with its reply
+func bitFieldResults(operations []types.BitFieldOperation, replies []interface{}) []types.BitFieldResult {
+	results := make([]types.BitFieldResult, 0, len(replies))
+	i := 0
+	for _, op := range operations {
+		if op.Op == "OVERFLOW" {
+			continue
+		}
+		result := types.BitFieldResult{Op: op.Op, Type: op.Type, Offset: op.Offset}
+		if i < len(replies) {
+			if value, ok := replies[i].(int64); ok {
+				result.Value = &value
+			}
+		}
+		results = append(results, result)
+		i++
+	}
+	return results
+}
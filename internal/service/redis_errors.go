@@ -0,0 +1,102 @@
+package service
+
+import (
+	stderrors "errors"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+)
+
+// typeMismatchCodes maps the first code of a module to the code it uses for WRONGTYPE replies
+var typeMismatchCodes = map[int]int{
+	2100: errors.CodeStringTypeMismatch,
+	2200: errors.CodeListTypeMismatch,
+	2300: errors.CodeHashTypeMismatch,
+	2400: errors.CodeSetTypeMismatch,
+	2500: errors.CodeZSetTypeMismatch,
+	2600: errors.CodeBitmapTypeMismatch,
+	2700: errors.CodeHLLTypeMismatch,
+	2800: errors.CodeGeoTypeMismatch,
+}
+
+// connectError maps a Connect failure to CodeRedisConnectFailed, or to the more precise code
+// when the target did not answer in time, rejected the credentials or has no such database.
+// When the circuit breaker rejected the request without trying the target, the details say
+// so and tells the caller when to retry. An unknown target name is a mistake of the caller
+// and maps to CodeInvalidParams, which is not retryable.
+func connectError(err error) errors.BusinessError {
+	if stderrors.Is(err, dao.ErrUnknownTarget) {
+		return errors.Wrap(err, errors.CodeInvalidParams).WithDetails("%v", err)
+	}
+
+	switch dao.ClassifyError(err) {
+	case dao.ErrorTimeout:
+		return errors.Wrap(err, errors.CodeRedisTimeout)
+	case dao.ErrorAuth:
+		return errors.Wrap(err, errors.CodeRedisAuthFailed)
+	case dao.ErrorDBSelect:
+		return errors.Wrap(err, errors.CodeRedisDBSelectFailed)
+	case dao.ErrorLoading:
+		return errors.Wrap(err, errors.CodeRedisLoading)
+	}
+	bizErr := errors.Wrap(err, errors.CodeRedisConnectFailed)
+
+	var open *dao.BreakerOpenError
+	if !stderrors.As(err, &open) {
+		return bizErr
+	}
+	retryAfter := open.RetryAfter.Milliseconds()
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	return bizErr.WithDetails("circuit breaker of %s is open, retry after %dms", strings.TrimPrefix(open.Target, "addr:"), retryAfter)
+}
+
+// redisError maps a failed Redis call to a business error. Replies that mean the same to
+// every operation, such as OOM or READONLY, get their own code, a refused, reset or closed
+// connection maps to the retryable CodeRedisConnectFailed, WRONGTYPE gets the type
+// mismatch code of the module, and anything else the given code of the operation.
+// The Redis error is kept as the cause for logging; clients only see the registered message.
+func redisError(err error, code int, args ...interface{}) errors.BusinessError {
+	switch dao.ClassifyError(err) {
+	case dao.ErrorTimeout:
+		return errors.Wrap(err, errors.CodeRedisTimeout)
+	case dao.ErrorWrongType:
+		if mismatch, ok := typeMismatchCodes[code/100*100]; ok {
+			return errors.Wrap(err, mismatch)
+		}
+	case dao.ErrorAuth:
+		return errors.Wrap(err, errors.CodeRedisAuthFailed)
+	case dao.ErrorOOM:
+		return errors.Wrap(err, errors.CodeRedisOutOfMemory)
+	case dao.ErrorReadOnly:
+		return errors.Wrap(err, errors.CodeRedisReadOnly)
+	case dao.ErrorBusy:
+		return errors.Wrap(err, errors.CodeRedisBusy)
+	case dao.ErrorLoading:
+		return errors.Wrap(err, errors.CodeRedisLoading)
+	case dao.ErrorConnection, dao.ErrorMasterDown:
+		return errors.Wrap(err, errors.CodeRedisConnectFailed)
+	case dao.ErrorCanceled:
+		// The caller went away and nobody reads the response, the code of the
+		// operation is kept so that the log shows what was interrupted
+		return errors.Wrap(err, code, args...)
+	case dao.ErrorRedirect, dao.ErrorTryAgain:
+		return redirectError(err)
+	case dao.ErrorClusterDown:
+		return errors.Wrap(err, errors.CodeRedisClusterDown)
+	}
+	return errors.Wrap(err, code, args...)
+}
+
+// redirectError maps MOVED, ASK and TRYAGAIN to CodeRedisClusterRedirect, naming the slot
+// but not the node address, which is internal to the deployment
+func redirectError(err error) errors.BusinessError {
+	bizErr := errors.Wrap(err, errors.CodeRedisClusterRedirect)
+	slot := dao.RedirectSlot(err)
+	if slot < 0 {
+		return bizErr
+	}
+	return bizErr.WithDetails("slot %d", slot)
+}
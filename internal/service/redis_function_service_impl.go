@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisFunctionServiceImpl implements the RedisFunctionService interface
+type RedisFunctionServiceImpl struct {
+	redisDAO dao.RedisDAO
+	replicas map[string]string
+}
+
+// NewRedisFunctionService creates a new RedisFunctionServiceImpl instance
+func NewRedisFunctionService(redisDAO dao.RedisDAO, cfg config.FunctionConfig) RedisFunctionService {
+	return &RedisFunctionServiceImpl{
+		redisDAO: redisDAO,
+		replicas: cfg.Replicas,
+	}
+}
+
+// Load loads a function library, optionally replacing an existing one
+func (s *RedisFunctionServiceImpl) Load(ctx context.Context, req *types.FunctionLoadRequest) (*types.FunctionLoadData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FUNCTION"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	name, err := conn.FunctionLoad(ctx, req.Code, req.Replace)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionLoadFailed)
+	}
+
+	return &types.FunctionLoadData{LibraryName: name}, nil
+}
+
+// List lists function libraries and their functions
+func (s *RedisFunctionServiceImpl) List(ctx context.Context, req *types.FunctionListRequest) (*types.FunctionListData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FUNCTION"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	libraries, err := conn.FunctionList(ctx, req.LibraryName, req.WithCode)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionAdminFailed)
+	}
+
+	return &types.FunctionListData{Libraries: libraries}, nil
+}
+
+// Delete deletes a function library
+func (s *RedisFunctionServiceImpl) Delete(ctx context.Context, req *types.FunctionDeleteRequest) (*types.FunctionDeleteData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FUNCTION"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.FunctionDelete(ctx, req.LibraryName)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionAdminFailed)
+	}
+
+	return &types.FunctionDeleteData{Result: result}, nil
+}
+
+// Dump serializes all function libraries into a base64 encoded payload
+func (s *RedisFunctionServiceImpl) Dump(ctx context.Context, req *types.FunctionDumpRequest) (*types.FunctionDumpData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FUNCTION"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	payload, err := conn.FunctionDump(ctx)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionDumpFailed)
+	}
+
+	return &types.FunctionDumpData{Payload: base64.StdEncoding.EncodeToString([]byte(payload))}, nil
+}
+
+// Restore restores function libraries from a base64 encoded FUNCTION DUMP payload
+func (s *RedisFunctionServiceImpl) Restore(ctx context.Context, req *types.FunctionRestoreRequest) (*types.FunctionRestoreData, error) {
+	payload, err := base64.StdEncoding.DecodeString(req.Payload)
+	if err != nil {
+		return nil, errors.NewError(errors.CodeInvalidParams)
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FUNCTION"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.FunctionRestore(ctx, string(payload), req.Policy)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionRestoreFailed)
+	}
+
+	return &types.FunctionRestoreData{Result: result}, nil
+}
+
+// Call invokes a function with FCALL on the primary
+func (s *RedisFunctionServiceImpl) Call(ctx context.Context, req *types.FunctionCallRequest) (*types.FunctionCallData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FCALL"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "FCALL", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.FunctionCall(ctx, req.Function, req.Keys, req.Args, false)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionCallFailed)
+	}
+
+	return &types.FunctionCallData{Result: result}, nil
+}
+
+// CallRO invokes a read-only function with FCALL_RO. When a replica is
+// configured for the requested address the call is sent there instead,
+// using the same credentials and database, unless use_primary is set or
+// strong consistency is requested.
+// Named targets follow their own replica reads setting.
+func (s *RedisFunctionServiceImpl) CallRO(ctx context.Context, req *types.FunctionCallRORequest) (*types.FunctionCallData, error) {
+	target := req.RedisRequest
+	replica, ok := s.replicas[req.Addr]
+	useReplica := ok && target.Target == "" && !req.UsePrimary && target.Consistency != types.ConsistencyStrong
+	if useReplica {
+		target.Addr = replica
+	}
+
+	// Connect to Redis
+	connect := s.redisDAO.ConnectRead
+	if req.UsePrimary {
+		connect = s.redisDAO.Connect
+	}
+	conn, err := connect(ctx, target)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "FCALL_RO"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "FCALL_RO", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.FunctionCall(ctx, req.Function, req.Keys, req.Args, true)
+	if err != nil {
+		return nil, redisError(err, errors.CodeFunctionCallFailed)
+	}
+
+	return &types.FunctionCallData{Result: result, Replica: useReplica}, nil
+}
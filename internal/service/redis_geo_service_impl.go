@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisGeoServiceImpl implements the RedisGeoService interface
+type RedisGeoServiceImpl struct {
+	redisDAO dao.RedisDAO
+}
+
+// NewRedisGeoService creates a new RedisGeoServiceImpl instance
+func NewRedisGeoService(redisDAO dao.RedisDAO) RedisGeoService {
+	return &RedisGeoServiceImpl{
+		redisDAO: redisDAO,
+	}
+}
+
+// GeoAdd adds locations to a geospatial index
+func (s *RedisGeoServiceImpl) GeoAdd(ctx context.Context, req *types.GeoAddRequest) (*types.GeoAddData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.NX || req.XX || req.CH {
+		if err := checkCommandSupported(ctx, conn, "GEOADD NX/XX/CH"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call DAO layer
+	count, err := conn.GeoAdd(ctx, req.Key, req.Locations, req.GeoAddFlags)
+	if err != nil {
+		return nil, redisError(err, errors.CodeGeoAddFailed)
+	}
+
+	return &types.GeoAddData{Count: count}, nil
+}
+
+// GeoPos gets the positions of members
+func (s *RedisGeoServiceImpl) GeoPos(ctx context.Context, req *types.GeoPosRequest) (*types.GeoPosData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	positions, err := conn.GeoPos(ctx, req.Key, req.Members)
+	if err != nil {
+		return nil, redisError(err, errors.CodeGeoQueryFailed)
+	}
+
+	result := make([]types.GeoMemberPosition, len(req.Members))
+	for i, member := range req.Members {
+		result[i] = types.GeoMemberPosition{Member: member}
+		if i < len(positions) {
+			result[i].Position = positions[i]
+		}
+	}
+	return &types.GeoPosData{Positions: result}, nil
+}
+
+// GeoDist gets the distance between two members
+func (s *RedisGeoServiceImpl) GeoDist(ctx context.Context, req *types.GeoDistRequest) (*types.GeoDistData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	distance, err := conn.GeoDist(ctx, req.Key, req.Member1, req.Member2, req.Unit)
+	if err != nil {
+		return nil, redisError(err, errors.CodeGeoQueryFailed)
+	}
+
+	return &types.GeoDistData{Distance: distance, Unit: req.Unit}, nil
+}
+
+// GeoHash gets the geohash strings of members
+func (s *RedisGeoServiceImpl) GeoHash(ctx context.Context, req *types.GeoHashRequest) (*types.GeoHashData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	hashes, err := conn.GeoHash(ctx, req.Key, req.Members)
+	if err != nil {
+		return nil, redisError(err, errors.CodeGeoQueryFailed)
+	}
+
+	result := make([]types.GeoMemberHash, len(req.Members))
+	for i, member := range req.Members {
+		result[i] = types.GeoMemberHash{Member: member}
+		if i < len(hashes) && hashes[i] != "" {
+			hash := hashes[i]
+			result[i].Hash = &hash
+		}
+	}
+	return &types.GeoHashData{Hashes: result}, nil
+}
+
+// GeoSearch searches members within a radius or box
+func (s *RedisGeoServiceImpl) GeoSearch(ctx context.Context, req *types.GeoSearchRequest) (*types.GeoSearchData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "GEOSEARCH"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	locations, err := conn.GeoSearch(ctx, req.Key, req.GeoSearchOptions, req.WithCoord, req.WithDist)
+	if err != nil {
+		return nil, redisError(err, errors.CodeGeoSearchFailed)
+	}
+
+	return &types.GeoSearchData{Locations: locations}, nil
+}
+
+// GeoSearchStore stores the result of a geo search in a destination key
+func (s *RedisGeoServiceImpl) GeoSearchStore(ctx context.Context, req *types.GeoSearchStoreRequest) (*types.GeoSearchStoreData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "GEOSEARCHSTORE"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "GEOSEARCHSTORE", req.Destination, req.Key); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	count, err := conn.GeoSearchStore(ctx, req.Destination, req.Key, req.GeoSearchOptions, req.StoreDist)
+	if err != nil {
+		return nil, redisError(err, errors.CodeGeoSearchFailed)
+	}
+
+	return &types.GeoSearchStoreData{Count: count}, nil
+}
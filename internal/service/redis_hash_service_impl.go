@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/ct-zh/go-redis-proxy/internal/dao"
 	"github.com/ct-zh/go-redis-proxy/pkg/errors"
@@ -22,16 +23,26 @@ func NewRedisHashService(redisDAO dao.RedisDAO) RedisHashService {
 
 // HSet sets field-value pairs in a hash
 func (s *RedisHashServiceImpl) HSet(ctx context.Context, req *types.HashHSetRequest) (*types.HashHSetData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, "")
+	if err != nil {
+		return nil, err
+	}
+	fields, err := codec.decodeFieldValues(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	set, err := s.redisDAO.HashHSet(ctx, req.Key, req.Fields)
+	set, err := conn.HashHSet(ctx, req.Key, fields)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashSetFailed)
+		return nil, redisError(err, errors.CodeHashSetFailed)
 	}
 
 	return &types.HashHSetData{Set: set}, nil
@@ -39,67 +50,118 @@ func (s *RedisHashServiceImpl) HSet(ctx context.Context, req *types.HashHSetRequ
 
 // HGet gets the value of a field in a hash
 func (s *RedisHashServiceImpl) HGet(ctx context.Context, req *types.HashHGetRequest) (*types.HashHGetData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, req.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+	field, err := codec.decode(req.Field)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	value, err := s.redisDAO.HashHGet(ctx, req.Key, req.Field)
+	value, err := conn.HashHGet(ctx, req.Key, field)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
+	}
+
+	document, ok := codec.encodeDocument(value)
+	if !ok {
+		return nil, errors.NewError(errors.CodeHashInvalidJSON).WithDetails("field %s", req.Field)
 	}
 
-	return &types.HashHGetData{Value: value}, nil
+	return &types.HashHGetData{Value: document}, nil
 }
 
 // HMGet gets values of multiple fields in a hash
 func (s *RedisHashServiceImpl) HMGet(ctx context.Context, req *types.HashHMGetRequest) (*types.HashHMGetData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, req.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := codec.decodeAll(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	values, err := s.redisDAO.HashHMGet(ctx, req.Key, req.Fields)
+	values, err := conn.HashHMGet(ctx, req.Key, fields)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
+	}
+
+	documents, invalid := codec.encodeDocuments(values)
+	if invalid >= 0 {
+		return nil, errors.NewError(errors.CodeHashInvalidJSON).WithDetails("field %s", req.Fields[invalid])
 	}
 
-	return &types.HashHMGetData{Values: values}, nil
+	return &types.HashHMGetData{Values: documents}, nil
 }
 
 // HGetAll gets all field-value pairs in a hash
 func (s *RedisHashServiceImpl) HGetAll(ctx context.Context, req *types.HashHGetAllRequest) (*types.HashHGetAllData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, req.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	fields, err := s.redisDAO.HashHGetAll(ctx, req.Key)
+	fields, err := conn.HashHGetAll(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
+	}
+
+	documents, invalid, ok := codec.encodeFieldDocuments(fields)
+	if !ok {
+		return nil, errors.NewError(errors.CodeHashInvalidJSON).WithDetails("field %s", invalid)
 	}
 
-	return &types.HashHGetAllData{Fields: fields}, nil
+	return &types.HashHGetAllData{Fields: documents}, nil
 }
 
 // HDel deletes fields from a hash
 func (s *RedisHashServiceImpl) HDel(ctx context.Context, req *types.HashHDelRequest) (*types.HashHDelData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := codec.decodeAll(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	deleted, err := s.redisDAO.HashHDel(ctx, req.Key, req.Fields)
+	deleted, err := conn.HashHDel(ctx, req.Key, fields)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashDeleteFailed)
+		return nil, redisError(err, errors.CodeHashDeleteFailed)
 	}
 
 	return &types.HashHDelData{Deleted: deleted}, nil
@@ -107,16 +169,26 @@ func (s *RedisHashServiceImpl) HDel(ctx context.Context, req *types.HashHDelRequ
 
 // HExists checks if a field exists in a hash
 func (s *RedisHashServiceImpl) HExists(ctx context.Context, req *types.HashHExistsRequest) (*types.HashHExistsData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	field, err := codec.decode(req.Field)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	exists, err := s.redisDAO.HashHExists(ctx, req.Key, req.Field)
+	exists, err := conn.HashHExists(ctx, req.Key, field)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
 	}
 
 	return &types.HashHExistsData{Exists: exists}, nil
@@ -125,15 +197,16 @@ func (s *RedisHashServiceImpl) HExists(ctx context.Context, req *types.HashHExis
 // HLen gets the number of fields in a hash
 func (s *RedisHashServiceImpl) HLen(ctx context.Context, req *types.HashHLenRequest) (*types.HashHLenData, error) {
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	length, err := s.redisDAO.HashHLen(ctx, req.Key)
+	length, err := conn.HashHLen(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
 	}
 
 	return &types.HashHLenData{Length: length}, nil
@@ -141,51 +214,289 @@ func (s *RedisHashServiceImpl) HLen(ctx context.Context, req *types.HashHLenRequ
 
 // HKeys gets all field names in a hash
 func (s *RedisHashServiceImpl) HKeys(ctx context.Context, req *types.HashHKeysRequest) (*types.HashHKeysData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	keys, err := s.redisDAO.HashHKeys(ctx, req.Key)
+	keys, err := conn.HashHKeys(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
 	}
 
-	return &types.HashHKeysData{Keys: keys}, nil
+	return &types.HashHKeysData{Keys: codec.encodeAll(keys)}, nil
 }
 
 // HVals gets all field values in a hash
 func (s *RedisHashServiceImpl) HVals(ctx context.Context, req *types.HashHValsRequest) (*types.HashHValsData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	values, err := s.redisDAO.HashHVals(ctx, req.Key)
+	values, err := conn.HashHVals(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashGetFailed)
+		return nil, redisError(err, errors.CodeHashGetFailed)
 	}
 
-	return &types.HashHValsData{Values: values}, nil
+	return &types.HashHValsData{Values: codec.encodeAll(values)}, nil
 }
 
 // HIncrBy increments the value of a field in a hash by an integer
 func (s *RedisHashServiceImpl) HIncrBy(ctx context.Context, req *types.HashHIncrByRequest) (*types.HashHIncrByData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	field, err := codec.decode(req.Field)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	value, err := s.redisDAO.HashHIncrBy(ctx, req.Key, req.Field, req.Increment)
+	value, err := conn.HashHIncrBy(ctx, req.Key, field, req.Increment)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeHashIncrementFailed)
+		return nil, redisError(err, errors.CodeHashIncrementFailed)
 	}
 
 	return &types.HashHIncrByData{Value: value}, nil
-}
\ No newline at end of file
+}
+
+// HSetNX sets a field in a hash only if it does not exist yet
+func (s *RedisHashServiceImpl) HSetNX(ctx context.Context, req *types.HashHSetNXRequest) (*types.HashHSetNXData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	field, err := codec.decode(req.Field)
+	if err != nil {
+		return nil, err
+	}
+	value, err := codec.decode(req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	set, err := conn.HashHSetNX(ctx, req.Key, field, value)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashSetFailed)
+	}
+
+	return &types.HashHSetNXData{Set: set}, nil
+}
+
+// HIncrByFloat increments the value of a field in a hash by a float
+func (s *RedisHashServiceImpl) HIncrByFloat(ctx context.Context, req *types.HashHIncrByFloatRequest) (*types.HashHIncrByFloatData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	field, err := codec.decode(req.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	value, err := conn.HashHIncrByFloat(ctx, req.Key, field, req.Increment)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashIncrementFailed)
+	}
+
+	return &types.HashHIncrByFloatData{Value: value}, nil
+}
+
+// HStrLen gets the string length of the value of a field in a hash
+func (s *RedisHashServiceImpl) HStrLen(ctx context.Context, req *types.HashHStrLenRequest) (*types.HashHStrLenData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	field, err := codec.decode(req.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	length, err := conn.HashHStrLen(ctx, req.Key, field)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashGetFailed)
+	}
+
+	return &types.HashHStrLenData{Length: length}, nil
+}
+
+// HRandField returns random fields from a hash
+func (s *RedisHashServiceImpl) HRandField(ctx context.Context, req *types.HashHRandFieldRequest) (*types.HashHRandFieldData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "HRANDFIELD"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	fields, err := conn.HashHRandField(ctx, req.Key, req.Count, req.WithValues)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashGetFailed)
+	}
+
+	for i, f := range fields {
+		fields[i] = types.HashField{Field: codec.encode(f.Field), Value: codec.encode(f.Value)}
+	}
+	if req.WithValues {
+		return &types.HashHRandFieldData{Fields: fields}, nil
+	}
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Field
+	}
+	return &types.HashHRandFieldData{Fields: names}, nil
+}
+
+// HExpire sets a TTL on individual hash fields
+func (s *RedisHashServiceImpl) HExpire(ctx context.Context, req *types.HashHExpireRequest) (*types.HashHExpireData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := codec.decodeAll(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "HEXPIRE"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	ttl := time.Duration(req.TTL) * time.Second
+	results, err := conn.HashHExpire(ctx, req.Key, ttl, req.Condition, fields)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashExpireFailed)
+	}
+
+	return &types.HashHExpireData{Results: results}, nil
+}
+
+// HTTL gets the remaining TTL of individual hash fields
+func (s *RedisHashServiceImpl) HTTL(ctx context.Context, req *types.HashHTTLRequest) (*types.HashHTTLData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := codec.decodeAll(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "HTTL"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	ttls, err := conn.HashHTTL(ctx, req.Key, fields)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashGetFailed)
+	}
+
+	return &types.HashHTTLData{TTLs: ttls}, nil
+}
+
+// HPersist removes the TTL of individual hash fields
+func (s *RedisHashServiceImpl) HPersist(ctx context.Context, req *types.HashHPersistRequest) (*types.HashHPersistData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := codec.decodeAll(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "HPERSIST"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	results, err := conn.HashHPersist(ctx, req.Key, fields)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHashExpireFailed)
+	}
+
+	return &types.HashHPersistData{Results: results}, nil
+}
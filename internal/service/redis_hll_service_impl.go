@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisHLLServiceImpl implements the RedisHLLService interface
+type RedisHLLServiceImpl struct {
+	redisDAO dao.RedisDAO
+}
+
+// NewRedisHLLService creates a new RedisHLLServiceImpl instance
+func NewRedisHLLService(redisDAO dao.RedisDAO) RedisHLLService {
+	return &RedisHLLServiceImpl{
+		redisDAO: redisDAO,
+	}
+}
+
+// PFAdd adds elements to a HyperLogLog
+func (s *RedisHLLServiceImpl) PFAdd(ctx context.Context, req *types.HLLPFAddRequest) (*types.HLLPFAddData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	updated, err := conn.HLLPFAdd(ctx, req.Key, req.Elements)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHLLAddFailed)
+	}
+
+	return &types.HLLPFAddData{Updated: updated == 1}, nil
+}
+
+// PFCount returns the approximated cardinality of one or more HyperLogLogs
+func (s *RedisHLLServiceImpl) PFCount(ctx context.Context, req *types.HLLPFCountRequest) (*types.HLLPFCountData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "PFCOUNT", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	count, err := conn.HLLPFCount(ctx, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHLLCountFailed)
+	}
+
+	return &types.HLLPFCountData{Count: count}, nil
+}
+
+// PFMerge merges HyperLogLogs into a destination key
+func (s *RedisHLLServiceImpl) PFMerge(ctx context.Context, req *types.HLLPFMergeRequest) (*types.HLLPFMergeData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "PFMERGE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.HLLPFMerge(ctx, req.Destination, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeHLLMergeFailed)
+	}
+
+	return &types.HLLPFMergeData{Result: result}, nil
+}
@@ -22,15 +22,26 @@ func NewRedisListService(redisDAO dao.RedisDAO) *RedisListServiceImpl {
 
 // LPush pushes values to the left of a list
 func (s *RedisListServiceImpl) LPush(ctx context.Context, req *types.ListLPushRequest) (*types.ListLPushData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	values, err := codec.decodeAll(req.Values)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Push values to the left
-	length, err := s.dao.ListLPush(ctx, req.Key, req.Values)
+	length, err := conn.ListLPush(ctx, req.Key, values)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListPushFailed)
+		return nil, redisError(err, errors.CodeListPushFailed)
 	}
 
 	return &types.ListLPushData{
@@ -40,15 +51,26 @@ func (s *RedisListServiceImpl) LPush(ctx context.Context, req *types.ListLPushRe
 
 // RPush pushes values to the right of a list
 func (s *RedisListServiceImpl) RPush(ctx context.Context, req *types.ListRPushRequest) (*types.ListRPushData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	values, err := codec.decodeAll(req.Values)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Push values to the right
-	length, err := s.dao.ListRPush(ctx, req.Key, req.Values)
+	length, err := conn.ListRPush(ctx, req.Key, values)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListPushFailed)
+		return nil, redisError(err, errors.CodeListPushFailed)
 	}
 
 	return &types.ListRPushData{
@@ -58,51 +80,76 @@ func (s *RedisListServiceImpl) RPush(ctx context.Context, req *types.ListRPushRe
 
 // LPop pops a value from the left of a list
 func (s *RedisListServiceImpl) LPop(ctx context.Context, req *types.ListLPopRequest) (*types.ListLPopData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Pop value from the left
-	value, err := s.dao.ListLPop(ctx, req.Key)
+	value, err := conn.ListLPop(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListPopFailed)
+		return nil, redisError(err, errors.CodeListPopFailed)
 	}
 
 	return &types.ListLPopData{
-		Value: value,
+		Value: codec.encodeValue(value),
 	}, nil
 }
 
 // RPop pops a value from the right of a list
 func (s *RedisListServiceImpl) RPop(ctx context.Context, req *types.ListRPopRequest) (*types.ListRPopData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Pop value from the right
-	value, err := s.dao.ListRPop(ctx, req.Key)
+	value, err := conn.ListRPop(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListPopFailed)
+		return nil, redisError(err, errors.CodeListPopFailed)
 	}
 
 	return &types.ListRPopData{
-		Value: value,
+		Value: codec.encodeValue(value),
 	}, nil
 }
 
 // LRem removes elements from a list
 func (s *RedisListServiceImpl) LRem(ctx context.Context, req *types.ListLRemRequest) (*types.ListLRemData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	value, err := codec.decode(req.Value)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Remove elements
-	removed, err := s.dao.ListLRem(ctx, req.Key, req.Count, req.Value)
+	removed, err := conn.ListLRem(ctx, req.Key, req.Count, value)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListRemoveFailed)
+		return nil, redisError(err, errors.CodeListRemoveFailed)
 	}
 
 	return &types.ListLRemData{
@@ -112,51 +159,67 @@ func (s *RedisListServiceImpl) LRem(ctx context.Context, req *types.ListLRemRequ
 
 // LIndex gets an element from a list by index
 func (s *RedisListServiceImpl) LIndex(ctx context.Context, req *types.ListLIndexRequest) (*types.ListLIndexData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Get element by index
-	value, err := s.dao.ListLIndex(ctx, req.Key, req.Index)
+	value, err := conn.ListLIndex(ctx, req.Key, req.Index)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListIndexOutOfRange)
+		return nil, redisError(err, errors.CodeListQueryFailed)
 	}
 
 	return &types.ListLIndexData{
-		Value: value,
+		Value: codec.encodeValue(value),
 	}, nil
 }
 
 // LRange gets a range of elements from a list
 func (s *RedisListServiceImpl) LRange(ctx context.Context, req *types.ListLRangeRequest) (*types.ListLRangeData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Get range of elements
-	values, err := s.dao.ListLRange(ctx, req.Key, req.Start, req.Stop)
+	values, err := conn.ListLRange(ctx, req.Key, req.Start, req.Stop)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListIndexOutOfRange)
+		return nil, redisError(err, errors.CodeListQueryFailed)
 	}
 
 	return &types.ListLRangeData{
-		Values: values,
+		Values: codec.encodeAll(values),
 	}, nil
 }
 
 // LLen gets the length of a list
 func (s *RedisListServiceImpl) LLen(ctx context.Context, req *types.ListLLenRequest) (*types.ListLLenData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Get list length
-	length, err := s.dao.ListLLen(ctx, req.Key)
+	length, err := conn.ListLLen(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringGetFailed)
+		return nil, redisError(err, errors.CodeStringGetFailed)
 	}
 
 	return &types.ListLLenData{
@@ -167,14 +230,16 @@ func (s *RedisListServiceImpl) LLen(ctx context.Context, req *types.ListLLenRequ
 // LTrim trims a list to a specified range
 func (s *RedisListServiceImpl) LTrim(ctx context.Context, req *types.ListLTrimRequest) (*types.ListLTrimData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Trim the list
-	result, err := s.dao.ListLTrim(ctx, req.Key, req.Start, req.Stop)
+	result, err := conn.ListLTrim(ctx, req.Key, req.Start, req.Stop)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeListTrimFailed)
+		return nil, redisError(err, errors.CodeListTrimFailed)
 	}
 
 	return &types.ListLTrimData{
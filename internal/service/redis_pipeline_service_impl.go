@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// pipelineCommands lists the data commands that may be sent in a pipeline.
+// Administrative, blocking and scripting commands are excluded on purpose.
+var pipelineCommands = map[string]bool{
+	// String
+	"GET": true, "MGET": true, "SET": true, "DEL": true, "EXISTS": true,
+	"INCR": true, "DECR": true, "EXPIRE": true, "TTL": true,
+	// List
+	"LPUSH": true, "RPUSH": true, "LPOP": true, "RPOP": true, "LREM": true,
+	"LINDEX": true, "LRANGE": true, "LLEN": true, "LTRIM": true,
+	// Set
+	"SADD": true, "SREM": true, "SISMEMBER": true, "SMISMEMBER": true, "SMEMBERS": true,
+	"SCARD": true, "SINTER": true, "SINTERSTORE": true, "SINTERCARD": true, "SUNION": true,
+	"SUNIONSTORE": true, "SDIFF": true, "SDIFFSTORE": true, "SMOVE": true, "SPOP": true,
+	"SRANDMEMBER": true,
+	// ZSet
+	"ZADD": true, "ZINCRBY": true, "ZSCORE": true, "ZMSCORE": true, "ZCARD": true,
+	"ZCOUNT": true, "ZRANK": true, "ZREVRANK": true, "ZRANGE": true, "ZREVRANGE": true,
+	"ZRANGEBYSCORE": true, "ZREVRANGEBYSCORE": true, "ZRANGEBYLEX": true, "ZLEXCOUNT": true,
+	"ZREM": true, "ZREMRANGEBYRANK": true, "ZREMRANGEBYSCORE": true, "ZREMRANGEBYLEX": true,
+	"ZPOPMIN": true, "ZPOPMAX": true, "ZRANDMEMBER": true, "ZUNION": true, "ZUNIONSTORE": true,
+	"ZINTER": true, "ZINTERSTORE": true, "ZDIFF": true, "ZDIFFSTORE": true,
+	// Hash
+	"HSET": true, "HGET": true, "HMGET": true, "HGETALL": true, "HDEL": true,
+	"HEXISTS": true, "HLEN": true, "HKEYS": true, "HVALS": true, "HINCRBY": true,
+	"HINCRBYFLOAT": true, "HSETNX": true, "HSTRLEN": true, "HRANDFIELD": true,
+	// Bitmap
+	"SETBIT": true, "GETBIT": true, "BITCOUNT": true, "BITPOS": true, "BITOP": true,
+	"BITFIELD": true, "BITFIELD_RO": true,
+	// HyperLogLog
+	"PFADD": true, "PFCOUNT": true, "PFMERGE": true,
+	// Geo
+	"GEOADD": true, "GEOPOS": true, "GEODIST": true, "GEOHASH": true, "GEOSEARCH": true,
+	"GEOSEARCHSTORE": true,
+}
+
+// pipelineMultiKey extracts the keys of the allowed multi-key commands, which must be
+// colocated on cluster and sharded targets. Every other command addresses a single key.
+var pipelineMultiKey = map[string]func(command []string) []string{
+	"MGET": argsFrom(1), "DEL": argsFrom(1), "EXISTS": argsFrom(1),
+	"SINTER": argsFrom(1), "SINTERSTORE": argsFrom(1), "SUNION": argsFrom(1),
+	"SUNIONSTORE": argsFrom(1), "SDIFF": argsFrom(1), "SDIFFSTORE": argsFrom(1),
+	"PFCOUNT": argsFrom(1), "PFMERGE": argsFrom(1), "BITOP": argsFrom(2),
+	"SMOVE": argsBetween(1, 3), "GEOSEARCHSTORE": argsBetween(1, 3),
+	"SINTERCARD": numKeys(false), "ZUNION": numKeys(false), "ZINTER": numKeys(false), "ZDIFF": numKeys(false),
+	"ZUNIONSTORE": numKeys(true), "ZINTERSTORE": numKeys(true), "ZDIFFSTORE": numKeys(true),
+}
+
+// argsFrom returns the arguments from index start on
+func argsFrom(start int) func([]string) []string {
+	return argsBetween(start, -1)
+}
+
+// argsBetween returns the arguments in [start, end), end -1 meaning all remaining arguments
+func argsBetween(start, end int) func([]string) []string {
+	return func(command []string) []string {
+		stop := end
+		if stop < 0 || stop > len(command) {
+			stop = len(command)
+		}
+		if start >= stop {
+			return nil
+		}
+		return command[start:stop]
+	}
+}
+
+// numKeys returns the keys of commands taking numkeys followed by the keys,
+// preceded by a destination key when withDest is set
+func numKeys(withDest bool) func([]string) []string {
+	return func(command []string) []string {
+		pos := 1
+		var keys []string
+		if withDest {
+			if len(command) < 2 {
+				return nil
+			}
+			keys = append(keys, command[1])
+			pos = 2
+		}
+		if pos >= len(command) {
+			return keys
+		}
+		n, err := strconv.Atoi(command[pos])
+		if err != nil || n < 0 {
+			return keys
+		}
+		end := pos + 1 + n
+		if end > len(command) {
+			end = len(command)
+		}
+		return append(keys, command[pos+1:end]...)
+	}
+}
+
+// RedisPipelineServiceImpl implements the RedisPipelineService interface
+type RedisPipelineServiceImpl struct {
+	redisDAO dao.RedisDAO
+}
+
+// NewRedisPipelineService creates a new RedisPipelineServiceImpl instance
+func NewRedisPipelineService(redisDAO dao.RedisDAO) RedisPipelineService {
+	return &RedisPipelineServiceImpl{
+		redisDAO: redisDAO,
+	}
+}
+
+// Exec sends the commands in a pipeline and returns their replies in request order.
+// On cluster and sharded targets the commands are grouped by node, multi-key commands
+// whose keys span hash slots or shards are rejected before anything is sent.
+func (s *RedisPipelineServiceImpl) Exec(ctx context.Context, req *types.PipelineRequest) (*types.PipelineData, error) {
+	for _, command := range req.Commands {
+		if !pipelineCommands[strings.ToUpper(command[0])] {
+			return nil, errors.NewError(errors.CodePipelineCommandNotAllowed, command[0])
+		}
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	for _, command := range req.Commands {
+		if keys, ok := pipelineMultiKey[strings.ToUpper(command[0])]; ok {
+			if err := checkColocated(conn, strings.ToUpper(command[0]), keys(command)...); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Call DAO layer
+	results, err := conn.Pipeline(ctx, req.Commands)
+	if err != nil {
+		return nil, redisError(err, errors.CodePipelineFailed)
+	}
+
+	return &types.PipelineData{Results: results}, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// RedisScriptServiceImpl implements the RedisScriptService interface
+type RedisScriptServiceImpl struct {
+	redisDAO   dao.RedisDAO
+	registry   *ScriptRegistry
+	enableEval bool
+}
+
+// NewRedisScriptService creates a new RedisScriptServiceImpl instance
+func NewRedisScriptService(redisDAO dao.RedisDAO, registry *ScriptRegistry, cfg config.ScriptConfig) RedisScriptService {
+	return &RedisScriptServiceImpl{
+		redisDAO:   redisDAO,
+		registry:   registry,
+		enableEval: cfg.EnableEval,
+	}
+}
+
+// Eval runs an ad-hoc Lua script; only allowed when enable_eval is configured
+func (s *RedisScriptServiceImpl) Eval(ctx context.Context, req *types.ScriptEvalRequest) (*types.ScriptEvalData, error) {
+	if !s.enableEval {
+		return nil, errors.NewError(errors.CodeScriptEvalDisabled)
+	}
+
+	// Connect to Redis, read-only scripts may run on a replica
+	connect := s.redisDAO.Connect
+	if req.ReadOnly {
+		connect = s.redisDAO.ConnectRead
+	}
+	conn, err := connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.ReadOnly {
+		if err := checkCommandSupported(ctx, conn, "EVAL_RO"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkColocated(conn, "EVAL", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.ScriptEval(ctx, req.Script, req.Keys, req.Args, req.ReadOnly)
+	if err != nil {
+		return nil, redisError(err, errors.CodeScriptExecFailed)
+	}
+
+	return &types.ScriptEvalData{Result: result}, nil
+}
+
+// EvalSha runs a cached script by SHA1; only allowed when enable_eval is configured
+func (s *RedisScriptServiceImpl) EvalSha(ctx context.Context, req *types.ScriptEvalShaRequest) (*types.ScriptEvalData, error) {
+	if !s.enableEval {
+		return nil, errors.NewError(errors.CodeScriptEvalDisabled)
+	}
+
+	// Connect to Redis, read-only scripts may run on a replica
+	connect := s.redisDAO.Connect
+	if req.ReadOnly {
+		connect = s.redisDAO.ConnectRead
+	}
+	conn, err := connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.ReadOnly {
+		if err := checkCommandSupported(ctx, conn, "EVALSHA_RO"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkColocated(conn, "EVALSHA", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.ScriptEvalSha(ctx, req.SHA, req.Keys, req.Args, req.ReadOnly)
+	if err != nil {
+		return nil, redisError(err, errors.CodeScriptExecFailed)
+	}
+
+	return &types.ScriptEvalData{Result: result}, nil
+}
+
+// Run executes a registered script by name. It calls EVALSHA with the
+// precomputed SHA1 and, if the server's script cache does not hold the
+// script yet, loads it and retries once.
+func (s *RedisScriptServiceImpl) Run(ctx context.Context, req *types.ScriptRunRequest) (*types.ScriptEvalData, error) {
+	script, ok := s.registry.get(req.Name)
+	if !ok {
+		return nil, errors.NewError(errors.CodeScriptNotFound, req.Name)
+	}
+	if !script.enabled {
+		return nil, errors.NewError(errors.CodeScriptDisabled, req.Name)
+	}
+
+	// Connect to Redis, read-only scripts may run on a replica
+	connect := s.redisDAO.Connect
+	if req.ReadOnly {
+		connect = s.redisDAO.ConnectRead
+	}
+	conn, err := connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.ReadOnly {
+		if err := checkCommandSupported(ctx, conn, "EVALSHA_RO"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkColocated(conn, "EVALSHA", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	result, err := conn.ScriptEvalSha(ctx, script.sha, req.Keys, req.Args, req.ReadOnly)
+	if err != nil && isNoScriptError(err) {
+		if _, err := conn.ScriptLoad(ctx, script.source); err != nil {
+			return nil, redisError(err, errors.CodeScriptLoadFailed)
+		}
+		result, err = conn.ScriptEvalSha(ctx, script.sha, req.Keys, req.Args, req.ReadOnly)
+	}
+	if err != nil {
+		return nil, redisError(err, errors.CodeScriptExecFailed)
+	}
+
+	return &types.ScriptEvalData{Result: result}, nil
+}
+
+// ScriptLoad loads a script into the server's script cache; only allowed when enable_eval is configured
+func (s *RedisScriptServiceImpl) ScriptLoad(ctx context.Context, req *types.ScriptLoadRequest) (*types.ScriptLoadData, error) {
+	if !s.enableEval {
+		return nil, errors.NewError(errors.CodeScriptEvalDisabled)
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	sha, err := conn.ScriptLoad(ctx, req.Script)
+	if err != nil {
+		return nil, redisError(err, errors.CodeScriptLoadFailed)
+	}
+
+	return &types.ScriptLoadData{SHA: sha}, nil
+}
+
+// ScriptExists checks whether scripts are in the server's script cache
+func (s *RedisScriptServiceImpl) ScriptExists(ctx context.Context, req *types.ScriptExistsRequest) (*types.ScriptExistsData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	exists, err := conn.ScriptExists(ctx, req.SHAs)
+	if err != nil {
+		return nil, redisError(err, errors.CodeScriptAdminFailed)
+	}
+
+	return &types.ScriptExistsData{Exists: exists}, nil
+}
+
+// ScriptFlush empties the server's script cache; only allowed when enable_eval is configured.
+// Named scripts keep working afterwards since Run reloads them on NOSCRIPT.
+func (s *RedisScriptServiceImpl) ScriptFlush(ctx context.Context, req *types.ScriptFlushRequest) (*types.ScriptFlushData, error) {
+	if !s.enableEval {
+		return nil, errors.NewError(errors.CodeScriptEvalDisabled)
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if req.Mode != "" {
+		if err := checkCommandSupported(ctx, conn, "SCRIPT FLUSH ASYNC/SYNC"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call DAO layer
+	result, err := conn.ScriptFlush(ctx, req.Mode)
+	if err != nil {
+		return nil, redisError(err, errors.CodeScriptAdminFailed)
+	}
+
+	return &types.ScriptFlushData{Result: result}, nil
+}
+
+// List returns the named scripts known to the proxy
+func (s *RedisScriptServiceImpl) List(ctx context.Context) (*types.ScriptListData, error) {
+	return &types.ScriptListData{
+		Scripts:     s.registry.List(),
+		EvalEnabled: s.enableEval,
+	}, nil
+}
+
+// isNoScriptError reports whether Redis rejected EVALSHA because the script is not cached
+func isNoScriptError(err error) bool {
+	return strings.HasPrefix(err.Error(), "NOSCRIPT")
+}
@@ -10,6 +10,7 @@ import (
 // 返回业务数据和错误，Handler层负责包装响应格式
 type RedisStringService interface {
 	Get(ctx context.Context, req *types.StringGetRequest) (*types.StringGetData, error)
+	MGet(ctx context.Context, req *types.StringMGetRequest) (*types.StringMGetData, error)
 	Set(ctx context.Context, req *types.StringSetRequest) (*types.StringSetData, error)
 	Del(ctx context.Context, req *types.StringDelRequest) (*types.StringDelData, error)
 	Exists(ctx context.Context, req *types.StringExistsRequest) (*types.StringExistsData, error)
@@ -49,6 +50,20 @@ type RedisZSetService interface {
 	ZRem(ctx context.Context, req *types.ZSetZRemRequest) (*types.ZSetZRemData, error)
 	ZRemRangeByRank(ctx context.Context, req *types.ZSetZRemRangeByRankRequest) (*types.ZSetZRemRangeByRankData, error)
 	ZRemRangeByScore(ctx context.Context, req *types.ZSetZRemRangeByScoreRequest) (*types.ZSetZRemRangeByScoreData, error)
+	ZRangeByLex(ctx context.Context, req *types.ZSetZRangeByLexRequest) (*types.ZSetZRangeByLexData, error)
+	ZLexCount(ctx context.Context, req *types.ZSetZLexCountRequest) (*types.ZSetZLexCountData, error)
+	ZRemRangeByLex(ctx context.Context, req *types.ZSetZRemRangeByLexRequest) (*types.ZSetZRemRangeByLexData, error)
+	ZPopMin(ctx context.Context, req *types.ZSetZPopMinRequest) (*types.ZSetZPopMinData, error)
+	ZPopMax(ctx context.Context, req *types.ZSetZPopMaxRequest) (*types.ZSetZPopMaxData, error)
+	BZPopMin(ctx context.Context, req *types.ZSetBZPopMinRequest) (*types.ZSetBZPopMinData, error)
+	ZMScore(ctx context.Context, req *types.ZSetZMScoreRequest) (*types.ZSetZMScoreData, error)
+	ZRandMember(ctx context.Context, req *types.ZSetZRandMemberRequest) (*types.ZSetZRandMemberData, error)
+	ZUnion(ctx context.Context, req *types.ZSetZUnionRequest) (*types.ZSetZUnionData, error)
+	ZUnionStore(ctx context.Context, req *types.ZSetZUnionStoreRequest) (*types.ZSetStoreData, error)
+	ZInter(ctx context.Context, req *types.ZSetZInterRequest) (*types.ZSetZInterData, error)
+	ZInterStore(ctx context.Context, req *types.ZSetZInterStoreRequest) (*types.ZSetStoreData, error)
+	ZDiff(ctx context.Context, req *types.ZSetZDiffRequest) (*types.ZSetZDiffData, error)
+	ZDiffStore(ctx context.Context, req *types.ZSetZDiffStoreRequest) (*types.ZSetStoreData, error)
 }
 
 // RedisHashService defines the business logic interface for Redis hash operations
@@ -64,4 +79,78 @@ type RedisHashService interface {
 	HKeys(ctx context.Context, req *types.HashHKeysRequest) (*types.HashHKeysData, error)
 	HVals(ctx context.Context, req *types.HashHValsRequest) (*types.HashHValsData, error)
 	HIncrBy(ctx context.Context, req *types.HashHIncrByRequest) (*types.HashHIncrByData, error)
-}
\ No newline at end of file
+	HSetNX(ctx context.Context, req *types.HashHSetNXRequest) (*types.HashHSetNXData, error)
+	HIncrByFloat(ctx context.Context, req *types.HashHIncrByFloatRequest) (*types.HashHIncrByFloatData, error)
+	HStrLen(ctx context.Context, req *types.HashHStrLenRequest) (*types.HashHStrLenData, error)
+	HRandField(ctx context.Context, req *types.HashHRandFieldRequest) (*types.HashHRandFieldData, error)
+	HExpire(ctx context.Context, req *types.HashHExpireRequest) (*types.HashHExpireData, error)
+	HTTL(ctx context.Context, req *types.HashHTTLRequest) (*types.HashHTTLData, error)
+	HPersist(ctx context.Context, req *types.HashHPersistRequest) (*types.HashHPersistData, error)
+}
+
+// RedisBitmapService defines the business logic interface for Redis bitmap operations
+// 返回业务数据和错误，Handler层负责包装响应格式
+type RedisBitmapService interface {
+	SetBit(ctx context.Context, req *types.BitmapSetBitRequest) (*types.BitmapSetBitData, error)
+	GetBit(ctx context.Context, req *types.BitmapGetBitRequest) (*types.BitmapGetBitData, error)
+	BitCount(ctx context.Context, req *types.BitmapBitCountRequest) (*types.BitmapBitCountData, error)
+	BitPos(ctx context.Context, req *types.BitmapBitPosRequest) (*types.BitmapBitPosData, error)
+	BitOp(ctx context.Context, req *types.BitmapBitOpRequest) (*types.BitmapBitOpData, error)
+	BitField(ctx context.Context, req *types.BitmapBitFieldRequest) (*types.BitmapBitFieldData, error)
+	BitFieldRO(ctx context.Context, req *types.BitmapBitFieldRORequest) (*types.BitmapBitFieldData, error)
+}
+
+// RedisHLLService defines the business logic interface for Redis HyperLogLog operations
+// 返回业务数据和错误，Handler层负责包装响应格式
+type RedisHLLService interface {
+	PFAdd(ctx context.Context, req *types.HLLPFAddRequest) (*types.HLLPFAddData, error)
+	PFCount(ctx context.Context, req *types.HLLPFCountRequest) (*types.HLLPFCountData, error)
+	PFMerge(ctx context.Context, req *types.HLLPFMergeRequest) (*types.HLLPFMergeData, error)
+}
+
+// RedisGeoService defines the business logic interface for Redis geospatial operations
+// 返回业务数据和错误，Handler层负责包装响应格式
+type RedisGeoService interface {
+	GeoAdd(ctx context.Context, req *types.GeoAddRequest) (*types.GeoAddData, error)
+	GeoPos(ctx context.Context, req *types.GeoPosRequest) (*types.GeoPosData, error)
+	GeoDist(ctx context.Context, req *types.GeoDistRequest) (*types.GeoDistData, error)
+	GeoHash(ctx context.Context, req *types.GeoHashRequest) (*types.GeoHashData, error)
+	GeoSearch(ctx context.Context, req *types.GeoSearchRequest) (*types.GeoSearchData, error)
+	GeoSearchStore(ctx context.Context, req *types.GeoSearchStoreRequest) (*types.GeoSearchStoreData, error)
+}
+
+// RedisScriptService defines the business logic interface for Redis Lua scripting
+// 返回业务数据和错误，Handler层负责包装响应格式
+type RedisScriptService interface {
+	Eval(ctx context.Context, req *types.ScriptEvalRequest) (*types.ScriptEvalData, error)
+	EvalSha(ctx context.Context, req *types.ScriptEvalShaRequest) (*types.ScriptEvalData, error)
+	Run(ctx context.Context, req *types.ScriptRunRequest) (*types.ScriptEvalData, error)
+	ScriptLoad(ctx context.Context, req *types.ScriptLoadRequest) (*types.ScriptLoadData, error)
+	ScriptExists(ctx context.Context, req *types.ScriptExistsRequest) (*types.ScriptExistsData, error)
+	ScriptFlush(ctx context.Context, req *types.ScriptFlushRequest) (*types.ScriptFlushData, error)
+	List(ctx context.Context) (*types.ScriptListData, error)
+}
+
+// RedisFunctionService defines the business logic interface for Redis Functions
+// 返回业务数据和错误，Handler层负责包装响应格式
+type RedisFunctionService interface {
+	Load(ctx context.Context, req *types.FunctionLoadRequest) (*types.FunctionLoadData, error)
+	List(ctx context.Context, req *types.FunctionListRequest) (*types.FunctionListData, error)
+	Delete(ctx context.Context, req *types.FunctionDeleteRequest) (*types.FunctionDeleteData, error)
+	Dump(ctx context.Context, req *types.FunctionDumpRequest) (*types.FunctionDumpData, error)
+	Restore(ctx context.Context, req *types.FunctionRestoreRequest) (*types.FunctionRestoreData, error)
+	Call(ctx context.Context, req *types.FunctionCallRequest) (*types.FunctionCallData, error)
+	CallRO(ctx context.Context, req *types.FunctionCallRORequest) (*types.FunctionCallData, error)
+}
+
+// RedisPipelineService defines the business logic interface for Redis pipelines
+// 返回业务数据和错误，Handler层负责包装响应格式
+type RedisPipelineService interface {
+	Exec(ctx context.Context, req *types.PipelineRequest) (*types.PipelineData, error)
+}
+
+// HealthService defines the business logic interface for health checks
+type HealthService interface {
+	Check(ctx context.Context) (*types.HealthData, error)
+	Breakers(ctx context.Context) (*types.BreakerData, error)
+}
@@ -13,4 +13,19 @@ type RedisSetService interface {
 	SIsMember(ctx context.Context, req *types.RedisSIsMemberRequest) (bool, error)
 	SMembers(ctx context.Context, req *types.RedisSMembersRequest) ([]string, error)
 	SCard(ctx context.Context, req *types.RedisSCardRequest) (int64, error)
+
+	// Set algebra across multiple keys
+	SInter(ctx context.Context, req *types.RedisSInterRequest) ([]string, error)
+	SInterStore(ctx context.Context, req *types.RedisSInterStoreRequest) (int64, error)
+	SInterCard(ctx context.Context, req *types.RedisSInterCardRequest) (int64, error)
+	SUnion(ctx context.Context, req *types.RedisSUnionRequest) ([]string, error)
+	SUnionStore(ctx context.Context, req *types.RedisSUnionStoreRequest) (int64, error)
+	SDiff(ctx context.Context, req *types.RedisSDiffRequest) ([]string, error)
+	SDiffStore(ctx context.Context, req *types.RedisSDiffStoreRequest) (int64, error)
+
+	// Moving and sampling members
+	SMove(ctx context.Context, req *types.RedisSMoveRequest) (bool, error)
+	SPop(ctx context.Context, req *types.RedisSPopRequest) ([]string, error)
+	SRandMember(ctx context.Context, req *types.RedisSRandMemberRequest) ([]string, error)
+	SMIsMember(ctx context.Context, req *types.RedisSMIsMemberRequest) ([]bool, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ct-zh/go-redis-proxy/internal/dao"
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
 	"github.com/ct-zh/go-redis-proxy/pkg/types"
 )
 
@@ -19,25 +20,334 @@ func NewRedisSetService(dao dao.RedisDAO) *RedisSetServiceImpl {
 
 // SAdd adds members to a set
 func (s *RedisSetServiceImpl) SAdd(ctx context.Context, req *types.RedisSAddRequest) (int64, error) {
-	return s.dao.SetSAdd(ctx, req.Key, req.Members)
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return 0, err
+	}
+	members, err := codec.decodeAll(req.Members)
+	if err != nil {
+		return 0, err
+	}
+
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+	added, err := conn.SetSAdd(ctx, req.Key, members)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetAddFailed)
+	}
+	return added, nil
 }
 
 // SRem removes members from a set
 func (s *RedisSetServiceImpl) SRem(ctx context.Context, req *types.RedisSRemRequest) (int64, error) {
-	return s.dao.SetSRem(ctx, req.Key, req.Members)
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return 0, err
+	}
+	members, err := codec.decodeAll(req.Members)
+	if err != nil {
+		return 0, err
+	}
+
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+	removed, err := conn.SetSRem(ctx, req.Key, members)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetRemoveFailed)
+	}
+	return removed, nil
 }
 
 // SIsMember checks if a member exists in a set
 func (s *RedisSetServiceImpl) SIsMember(ctx context.Context, req *types.RedisSIsMemberRequest) (bool, error) {
-	return s.dao.SetSIsMember(ctx, req.Key, req.Member)
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return false, err
+	}
+	member, err := codec.decode(req.Member)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return false, connectError(err)
+	}
+	defer conn.Close()
+	isMember, err := conn.SetSIsMember(ctx, req.Key, member)
+	if err != nil {
+		return false, redisError(err, errors.CodeSetQueryFailed)
+	}
+	return isMember, nil
 }
 
 // SMembers returns all members of a set
 func (s *RedisSetServiceImpl) SMembers(ctx context.Context, req *types.RedisSMembersRequest) ([]string, error) {
-	return s.dao.SetSMembers(ctx, req.Key)
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+	members, err := conn.SetSMembers(ctx, req.Key)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetQueryFailed)
+	}
+	return codec.encodeAll(members), nil
 }
 
 // SCard returns the number of members in a set
 func (s *RedisSetServiceImpl) SCard(ctx context.Context, req *types.RedisSCardRequest) (int64, error) {
-	return s.dao.SetSCard(ctx, req.Key)
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+	count, err := conn.SetSCard(ctx, req.Key)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetQueryFailed)
+	}
+	return count, nil
+}
+
+// SInter returns the intersection of multiple sets
+func (s *RedisSetServiceImpl) SInter(ctx context.Context, req *types.RedisSInterRequest) ([]string, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	members, err := conn.SetSInter(ctx, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return codec.encodeAll(members), nil
+}
+
+// SInterStore stores the intersection of multiple sets in the destination key
+func (s *RedisSetServiceImpl) SInterStore(ctx context.Context, req *types.RedisSInterStoreRequest) (int64, error) {
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "SINTERSTORE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return 0, err
+	}
+
+	count, err := conn.SetSInterStore(ctx, req.Destination, req.Keys)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return count, nil
+}
+
+// SInterCard returns the cardinality of the intersection of multiple sets
+func (s *RedisSetServiceImpl) SInterCard(ctx context.Context, req *types.RedisSInterCardRequest) (int64, error) {
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "SINTERCARD"); err != nil {
+		return 0, err
+	}
+
+	count, err := conn.SetSInterCard(ctx, req.Keys, req.Limit)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return count, nil
+}
+
+// SUnion returns the union of multiple sets
+func (s *RedisSetServiceImpl) SUnion(ctx context.Context, req *types.RedisSUnionRequest) ([]string, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	members, err := conn.SetSUnion(ctx, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return codec.encodeAll(members), nil
+}
+
+// SUnionStore stores the union of multiple sets in the destination key
+func (s *RedisSetServiceImpl) SUnionStore(ctx context.Context, req *types.RedisSUnionStoreRequest) (int64, error) {
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "SUNIONSTORE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return 0, err
+	}
+
+	count, err := conn.SetSUnionStore(ctx, req.Destination, req.Keys)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return count, nil
+}
+
+// SDiff returns the members of the first set that are not in any of the other sets
+func (s *RedisSetServiceImpl) SDiff(ctx context.Context, req *types.RedisSDiffRequest) ([]string, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	members, err := conn.SetSDiff(ctx, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return codec.encodeAll(members), nil
+}
+
+// SDiffStore stores the difference of multiple sets in the destination key
+func (s *RedisSetServiceImpl) SDiffStore(ctx context.Context, req *types.RedisSDiffStoreRequest) (int64, error) {
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return 0, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "SDIFFSTORE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return 0, err
+	}
+
+	count, err := conn.SetSDiffStore(ctx, req.Destination, req.Keys)
+	if err != nil {
+		return 0, redisError(err, errors.CodeSetAlgebraFailed)
+	}
+	return count, nil
+}
+
+// SMove moves a member from one set to another
+func (s *RedisSetServiceImpl) SMove(ctx context.Context, req *types.RedisSMoveRequest) (bool, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return false, err
+	}
+	member, err := codec.decode(req.Member)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return false, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "SMOVE", req.Source, req.Destination); err != nil {
+		return false, err
+	}
+
+	moved, err := conn.SetSMove(ctx, req.Source, req.Destination, member)
+	if err != nil {
+		return false, redisError(err, errors.CodeSetMoveFailed)
+	}
+	return moved, nil
+}
+
+// SPop removes and returns random members from a set
+func (s *RedisSetServiceImpl) SPop(ctx context.Context, req *types.RedisSPopRequest) ([]string, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	members, err := conn.SetSPop(ctx, req.Key, req.Count)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetPopFailed)
+	}
+	return codec.encodeAll(members), nil
+}
+
+// SRandMember returns random members from a set without removing them
+func (s *RedisSetServiceImpl) SRandMember(ctx context.Context, req *types.RedisSRandMemberRequest) ([]string, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	members, err := conn.SetSRandMember(ctx, req.Key, req.Count)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetQueryFailed)
+	}
+	return codec.encodeAll(members), nil
+}
+
+// SMIsMember checks whether each of the given members exists in a set
+func (s *RedisSetServiceImpl) SMIsMember(ctx context.Context, req *types.RedisSMIsMemberRequest) ([]bool, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	members, err := codec.decodeAll(req.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "SMISMEMBER"); err != nil {
+		return nil, err
+	}
+
+	exists, err := conn.SetSMIsMember(ctx, req.Key, members)
+	if err != nil {
+		return nil, redisError(err, errors.CodeSetQueryFailed)
+	}
+	return exists, nil
 }
@@ -23,28 +23,81 @@ func NewRedisStringService(redisDAO dao.RedisDAO) *RedisStringServiceImpl {
 
 // Get retrieves a string value from Redis
 func (s *RedisStringServiceImpl) Get(ctx context.Context, req *types.StringGetRequest) (*types.StringGetData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, req.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Get the value
-	value, err := s.dao.StringGet(ctx, req.Key)
+	value, err := conn.StringGet(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringGetFailed)
+		return nil, redisError(err, errors.CodeStringGetFailed)
+	}
+
+	document, ok := codec.encodeDocument(value)
+	if !ok {
+		return nil, errors.NewError(errors.CodeStringInvalidJSON).WithDetails("key %s", req.Key)
 	}
 
 	return &types.StringGetData{
-		Value: value,
+		Value: document,
+	}, nil
+}
+
+// MGet retrieves the values of multiple keys in request order
+func (s *RedisStringServiceImpl) MGet(ctx context.Context, req *types.StringMGetRequest) (*types.StringMGetData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, req.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Get the values, split by hash slot or shard on cluster and sharded targets
+	values, err := conn.StringMGet(ctx, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeStringGetFailed)
+	}
+
+	documents, invalid := codec.encodeDocuments(values)
+	if invalid >= 0 {
+		return nil, errors.NewError(errors.CodeStringInvalidJSON).WithDetails("key %s", req.Keys[invalid])
+	}
+
+	return &types.StringMGetData{
+		Values: documents,
 	}, nil
 }
 
 // Set sets a string value in Redis
 func (s *RedisStringServiceImpl) Set(ctx context.Context, req *types.StringSetRequest) (*types.StringSetData, error) {
+	codec, err := newJSONValueCodec(req.ValueEncoding, "")
+	if err != nil {
+		return nil, err
+	}
+	value, err := codec.decodeValue(req.Value)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Set TTL
 	var ttl time.Duration
@@ -53,9 +106,9 @@ func (s *RedisStringServiceImpl) Set(ctx context.Context, req *types.StringSetRe
 	}
 
 	// Set the value
-	result, err := s.dao.StringSet(ctx, req.Key, req.Value, ttl)
+	result, err := conn.StringSet(ctx, req.Key, value, ttl)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringSetFailed)
+		return nil, redisError(err, errors.CodeStringSetFailed)
 	}
 
 	return &types.StringSetData{
@@ -66,14 +119,16 @@ func (s *RedisStringServiceImpl) Set(ctx context.Context, req *types.StringSetRe
 // Del deletes a key from Redis
 func (s *RedisStringServiceImpl) Del(ctx context.Context, req *types.StringDelRequest) (*types.StringDelData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Delete the key
-	deleted, err := s.dao.StringDel(ctx, req.Key)
+	deleted, err := conn.StringDel(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringDelFailed)
+		return nil, redisError(err, errors.CodeStringDelFailed)
 	}
 
 	return &types.StringDelData{
@@ -84,14 +139,16 @@ func (s *RedisStringServiceImpl) Del(ctx context.Context, req *types.StringDelRe
 // Exists checks if a key exists in Redis
 func (s *RedisStringServiceImpl) Exists(ctx context.Context, req *types.StringExistsRequest) (*types.StringExistsData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Check if key exists
-	exists, err := s.dao.StringExists(ctx, req.Key)
+	exists, err := conn.StringExists(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringGetFailed)
+		return nil, redisError(err, errors.CodeStringGetFailed)
 	}
 
 	return &types.StringExistsData{
@@ -102,14 +159,16 @@ func (s *RedisStringServiceImpl) Exists(ctx context.Context, req *types.StringEx
 // Incr increments the integer value of a key by 1
 func (s *RedisStringServiceImpl) Incr(ctx context.Context, req *types.StringIncrRequest) (*types.StringIncrData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Increment the value
-	value, err := s.dao.StringIncr(ctx, req.Key)
+	value, err := conn.StringIncr(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringIncrFailed)
+		return nil, redisError(err, errors.CodeStringIncrFailed)
 	}
 
 	return &types.StringIncrData{
@@ -120,14 +179,16 @@ func (s *RedisStringServiceImpl) Incr(ctx context.Context, req *types.StringIncr
 // Decr decrements the integer value of a key by 1
 func (s *RedisStringServiceImpl) Decr(ctx context.Context, req *types.StringDecrRequest) (*types.StringDecrData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Decrement the value
-	value, err := s.dao.StringDecr(ctx, req.Key)
+	value, err := conn.StringDecr(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringDecrFailed)
+		return nil, redisError(err, errors.CodeStringDecrFailed)
 	}
 
 	return &types.StringDecrData{
@@ -138,15 +199,17 @@ func (s *RedisStringServiceImpl) Decr(ctx context.Context, req *types.StringDecr
 // Expire sets TTL for a key
 func (s *RedisStringServiceImpl) Expire(ctx context.Context, req *types.StringExpireRequest) (*types.StringExpireData, error) {
 	// Connect to Redis
-	if err := s.dao.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.dao.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
+	defer conn.Close()
 
 	// Set TTL
 	ttl := time.Duration(req.TTL) * time.Second
-	success, err := s.dao.StringExpire(ctx, req.Key, ttl)
+	success, err := conn.StringExpire(ctx, req.Key, ttl)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeStringExpireFailed)
+		return nil, redisError(err, errors.CodeStringExpireFailed)
 	}
 
 	return &types.StringExpireData{
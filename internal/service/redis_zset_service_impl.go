@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/ct-zh/go-redis-proxy/internal/dao"
 	"github.com/ct-zh/go-redis-proxy/pkg/errors"
@@ -22,33 +24,93 @@ func NewRedisZSetService(redisDAO dao.RedisDAO) RedisZSetService {
 
 // ZAdd adds members with scores to a sorted set
 func (s *RedisZSetServiceImpl) ZAdd(ctx context.Context, req *types.ZSetZAddRequest) (*types.ZSetZAddData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	members, err := zAddMembers(req, codec)
+	if err != nil {
+		return nil, err
+	}
+
+	// INCR mode behaves like ZINCRBY on a single member
+	if req.Incr {
+		score, err := conn.ZSetZAddIncr(ctx, req.Key, members[0], req.ZAddFlags)
+		if err != nil {
+			return nil, redisError(err, errors.CodeZSetAddFailed)
+		}
+		var added int64
+		if score != nil {
+			added = 1
+		}
+		return &types.ZSetZAddData{Added: added, Score: score}, nil
 	}
-	defer s.redisDAO.Close()
 
 	// Call DAO layer
-	added, err := s.redisDAO.ZSetZAdd(ctx, req.Key, req.Members)
+	added, err := conn.ZSetZAdd(ctx, req.Key, members, req.ZAddFlags)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetAddFailed)
+		return nil, redisError(err, errors.CodeZSetAddFailed)
 	}
 
 	return &types.ZSetZAddData{Added: added}, nil
 }
 
+// zAddMembers returns the ordered member list of a ZADD request with the member names decoded.
+// Ordered members come first, followed by the map entries sorted by member
+// so that the command arguments are deterministic.
+func zAddMembers(req *types.ZSetZAddRequest, codec valueCodec) ([]types.ZSetMember, error) {
+	members := make([]types.ZSetMember, 0, len(req.ScoredMembers)+len(req.Members))
+	members = append(members, req.ScoredMembers...)
+
+	names := make([]string, 0, len(req.Members))
+	for member := range req.Members {
+		names = append(names, member)
+	}
+	sort.Strings(names)
+	for _, member := range names {
+		members = append(members, types.ZSetMember{Member: member, Score: req.Members[member]})
+	}
+
+	for i := range members {
+		member, err := codec.decode(members[i].Member)
+		if err != nil {
+			return nil, err
+		}
+		members[i].Member = member
+	}
+	return members, nil
+}
+
 // ZIncrBy increments the score of a member in a sorted set
 func (s *RedisZSetServiceImpl) ZIncrBy(ctx context.Context, req *types.ZSetZIncrByRequest) (*types.ZSetZIncrByData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	member, err := codec.decode(req.Member)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	score, err := s.redisDAO.ZSetZIncrBy(ctx, req.Key, req.Increment, req.Member)
+	score, err := conn.ZSetZIncrBy(ctx, req.Key, req.Increment, member)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetAddFailed)
+		return nil, redisError(err, errors.CodeZSetAddFailed)
 	}
 
 	return &types.ZSetZIncrByData{Score: score}, nil
@@ -56,16 +118,26 @@ func (s *RedisZSetServiceImpl) ZIncrBy(ctx context.Context, req *types.ZSetZIncr
 
 // ZScore gets the score of a member in a sorted set
 func (s *RedisZSetServiceImpl) ZScore(ctx context.Context, req *types.ZSetZScoreRequest) (*types.ZSetZScoreData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	member, err := codec.decode(req.Member)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	score, err := s.redisDAO.ZSetZScore(ctx, req.Key, req.Member)
+	score, err := conn.ZSetZScore(ctx, req.Key, member)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetMemberNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
 	return &types.ZSetZScoreData{Score: score}, nil
@@ -74,15 +146,16 @@ func (s *RedisZSetServiceImpl) ZScore(ctx context.Context, req *types.ZSetZScore
 // ZCard gets the number of members in a sorted set
 func (s *RedisZSetServiceImpl) ZCard(ctx context.Context, req *types.ZSetZCardRequest) (*types.ZSetZCardData, error) {
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	count, err := s.redisDAO.ZSetZCard(ctx, req.Key)
+	count, err := conn.ZSetZCard(ctx, req.Key)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
 	return &types.ZSetZCardData{Count: count}, nil
@@ -91,15 +164,16 @@ func (s *RedisZSetServiceImpl) ZCard(ctx context.Context, req *types.ZSetZCardRe
 // ZCount counts members in a sorted set within a score range
 func (s *RedisZSetServiceImpl) ZCount(ctx context.Context, req *types.ZSetZCountRequest) (*types.ZSetZCountData, error) {
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	count, err := s.redisDAO.ZSetZCount(ctx, req.Key, req.Min, req.Max)
+	count, err := conn.ZSetZCount(ctx, req.Key, req.Min, req.Max)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
 	return &types.ZSetZCountData{Count: count}, nil
@@ -107,16 +181,26 @@ func (s *RedisZSetServiceImpl) ZCount(ctx context.Context, req *types.ZSetZCount
 
 // ZRank gets the rank of a member in a sorted set (ascending order)
 func (s *RedisZSetServiceImpl) ZRank(ctx context.Context, req *types.ZSetZRankRequest) (*types.ZSetZRankData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	member, err := codec.decode(req.Member)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	rank, err := s.redisDAO.ZSetZRank(ctx, req.Key, req.Member)
+	rank, err := conn.ZSetZRank(ctx, req.Key, member)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
 	return &types.ZSetZRankData{Rank: rank}, nil
@@ -124,101 +208,182 @@ func (s *RedisZSetServiceImpl) ZRank(ctx context.Context, req *types.ZSetZRankRe
 
 // ZRevRank gets the rank of a member in a sorted set (descending order)
 func (s *RedisZSetServiceImpl) ZRevRank(ctx context.Context, req *types.ZSetZRevRankRequest) (*types.ZSetZRevRankData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	member, err := codec.decode(req.Member)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	rank, err := s.redisDAO.ZSetZRevRank(ctx, req.Key, req.Member)
+	rank, err := conn.ZSetZRevRank(ctx, req.Key, member)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
 	return &types.ZSetZRevRankData{Rank: rank}, nil
 }
 
-// ZRange gets members from a sorted set by rank range (ascending order)
+// ZRange gets members from a sorted set by rank range (ascending order),
+// or by score/lexicographical range when the BYSCORE/BYLEX modifiers are set
 func (s *RedisZSetServiceImpl) ZRange(ctx context.Context, req *types.ZSetZRangeRequest) (*types.ZSetZRangeData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	minBound, maxBound := req.Min, req.Max
+	if req.ByLex {
+		if minBound, err = codec.decodeLexBound(req.Min); err != nil {
+			return nil, err
+		}
+		if maxBound, err = codec.decodeLexBound(req.Max); err != nil {
+			return nil, err
+		}
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Plain rank range keeps using the classic ZRANGE form
+	if !req.ByScore && !req.ByLex && !req.Rev {
+		members, err := conn.ZSetZRange(ctx, req.Key, req.Start, req.Stop, req.WithScores)
+		if err != nil {
+			return nil, redisError(err, errors.CodeZSetQueryFailed)
+		}
+		return &types.ZSetZRangeData{Members: codec.encodeValues(members)}, nil
+	}
+
+	if err := checkCommandSupported(ctx, conn, "ZRANGE BYSCORE/BYLEX/REV"); err != nil {
+		return nil, err
+	}
+
+	opt := dao.ZRangeOptions{
+		Start:   req.Start,
+		Stop:    req.Stop,
+		ByScore: req.ByScore,
+		ByLex:   req.ByLex,
+		Rev:     req.Rev,
+		Offset:  req.Offset,
+		Count:   req.Count,
+	}
+	if req.ByScore || req.ByLex {
+		// Always min then max, with REV the client sends them as max min
+		opt.Start, opt.Stop = minBound, maxBound
 	}
-	defer s.redisDAO.Close()
 
 	// Call DAO layer
-	members, err := s.redisDAO.ZSetZRange(ctx, req.Key, req.Start, req.Stop, req.WithScores)
+	members, err := conn.ZSetZRangeArgs(ctx, req.Key, opt, req.WithScores)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
-	return &types.ZSetZRangeData{Members: members}, nil
+	return &types.ZSetZRangeData{Members: codec.encodeValues(members)}, nil
 }
 
 // ZRevRange gets members from a sorted set by rank range (descending order)
 func (s *RedisZSetServiceImpl) ZRevRange(ctx context.Context, req *types.ZSetZRevRangeRequest) (*types.ZSetZRevRangeData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	members, err := s.redisDAO.ZSetZRevRange(ctx, req.Key, req.Start, req.Stop, req.WithScores)
+	members, err := conn.ZSetZRevRange(ctx, req.Key, req.Start, req.Stop, req.WithScores)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
-	return &types.ZSetZRevRangeData{Members: members}, nil
+	return &types.ZSetZRevRangeData{Members: codec.encodeValues(members)}, nil
 }
 
 // ZRangeByScore gets members from a sorted set by score range (ascending order)
 func (s *RedisZSetServiceImpl) ZRangeByScore(ctx context.Context, req *types.ZSetZRangeByScoreRequest) (*types.ZSetZRangeByScoreData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	members, err := s.redisDAO.ZSetZRangeByScore(ctx, req.Key, req.Min, req.Max, req.WithScores, req.Offset, req.Count)
+	members, err := conn.ZSetZRangeByScore(ctx, req.Key, req.Min, req.Max, req.WithScores, req.Offset, req.Count)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
-	return &types.ZSetZRangeByScoreData{Members: members}, nil
+	return &types.ZSetZRangeByScoreData{Members: codec.encodeValues(members)}, nil
 }
 
 // ZRevRangeByScore gets members from a sorted set by score range (descending order)
 func (s *RedisZSetServiceImpl) ZRevRangeByScore(ctx context.Context, req *types.ZSetZRevRangeByScoreRequest) (*types.ZSetZRevRangeByScoreData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	members, err := s.redisDAO.ZSetZRevRangeByScore(ctx, req.Key, req.Max, req.Min, req.WithScores, req.Offset, req.Count)
+	members, err := conn.ZSetZRevRangeByScore(ctx, req.Key, req.Max, req.Min, req.WithScores, req.Offset, req.Count)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRankNotFound)
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
 	}
 
-	return &types.ZSetZRevRangeByScoreData{Members: members}, nil
+	return &types.ZSetZRevRangeByScoreData{Members: codec.encodeValues(members)}, nil
 }
 
 // ZRem removes members from a sorted set
 func (s *RedisZSetServiceImpl) ZRem(ctx context.Context, req *types.ZSetZRemRequest) (*types.ZSetZRemData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	members, err := codec.decodeAll(req.Members)
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	removed, err := s.redisDAO.ZSetZRem(ctx, req.Key, req.Members)
+	removed, err := conn.ZSetZRem(ctx, req.Key, members)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRemoveFailed)
+		return nil, redisError(err, errors.CodeZSetRemoveFailed)
 	}
 
 	return &types.ZSetZRemData{Removed: removed}, nil
@@ -227,15 +392,16 @@ func (s *RedisZSetServiceImpl) ZRem(ctx context.Context, req *types.ZSetZRemRequ
 // ZRemRangeByRank removes members from a sorted set by rank range
 func (s *RedisZSetServiceImpl) ZRemRangeByRank(ctx context.Context, req *types.ZSetZRemRangeByRankRequest) (*types.ZSetZRemRangeByRankData, error) {
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	removed, err := s.redisDAO.ZSetZRemRangeByRank(ctx, req.Key, req.Start, req.Stop)
+	removed, err := conn.ZSetZRemRangeByRank(ctx, req.Key, req.Start, req.Stop)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRemoveFailed)
+		return nil, redisError(err, errors.CodeZSetRemoveFailed)
 	}
 
 	return &types.ZSetZRemRangeByRankData{Removed: removed}, nil
@@ -244,16 +410,420 @@ func (s *RedisZSetServiceImpl) ZRemRangeByRank(ctx context.Context, req *types.Z
 // ZRemRangeByScore removes members from a sorted set by score range
 func (s *RedisZSetServiceImpl) ZRemRangeByScore(ctx context.Context, req *types.ZSetZRemRangeByScoreRequest) (*types.ZSetZRemRangeByScoreData, error) {
 	// Connect to Redis
-	if err := s.redisDAO.Connect(req.RedisRequest); err != nil {
-		return nil, errors.NewError(errors.CodeRedisConnectFailed)
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
 	}
-	defer s.redisDAO.Close()
+	defer conn.Close()
 
 	// Call DAO layer
-	removed, err := s.redisDAO.ZSetZRemRangeByScore(ctx, req.Key, req.Min, req.Max)
+	removed, err := conn.ZSetZRemRangeByScore(ctx, req.Key, req.Min, req.Max)
 	if err != nil {
-		return nil, errors.NewError(errors.CodeZSetRemoveFailed)
+		return nil, redisError(err, errors.CodeZSetRemoveFailed)
 	}
 
 	return &types.ZSetZRemRangeByScoreData{Removed: removed}, nil
 }
+
+// ZRangeByLex gets members from a sorted set by lexicographical range
+func (s *RedisZSetServiceImpl) ZRangeByLex(ctx context.Context, req *types.ZSetZRangeByLexRequest) (*types.ZSetZRangeByLexData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	minBound, err := codec.decodeLexBound(req.Min)
+	if err != nil {
+		return nil, err
+	}
+	maxBound, err := codec.decodeLexBound(req.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	members, err := conn.ZSetZRangeByLex(ctx, req.Key, minBound, maxBound, req.Offset, req.Count)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
+	}
+
+	return &types.ZSetZRangeByLexData{Members: codec.encodeAll(members)}, nil
+}
+
+// ZLexCount counts members in a sorted set within a lexicographical range
+func (s *RedisZSetServiceImpl) ZLexCount(ctx context.Context, req *types.ZSetZLexCountRequest) (*types.ZSetZLexCountData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	minBound, err := codec.decodeLexBound(req.Min)
+	if err != nil {
+		return nil, err
+	}
+	maxBound, err := codec.decodeLexBound(req.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	count, err := conn.ZSetZLexCount(ctx, req.Key, minBound, maxBound)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
+	}
+
+	return &types.ZSetZLexCountData{Count: count}, nil
+}
+
+// ZRemRangeByLex removes members from a sorted set by lexicographical range
+func (s *RedisZSetServiceImpl) ZRemRangeByLex(ctx context.Context, req *types.ZSetZRemRangeByLexRequest) (*types.ZSetZRemRangeByLexData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	minBound, err := codec.decodeLexBound(req.Min)
+	if err != nil {
+		return nil, err
+	}
+	maxBound, err := codec.decodeLexBound(req.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	removed, err := conn.ZSetZRemRangeByLex(ctx, req.Key, minBound, maxBound)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetRemoveFailed)
+	}
+
+	return &types.ZSetZRemRangeByLexData{Removed: removed}, nil
+}
+
+// ZPopMin removes and returns the members with the lowest scores
+func (s *RedisZSetServiceImpl) ZPopMin(ctx context.Context, req *types.ZSetZPopMinRequest) (*types.ZSetZPopMinData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	members, err := conn.ZSetZPopMin(ctx, req.Key, req.Count)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetPopFailed)
+	}
+
+	return &types.ZSetZPopMinData{Members: codec.encodeMembers(members)}, nil
+}
+
+// ZPopMax removes and returns the members with the highest scores
+func (s *RedisZSetServiceImpl) ZPopMax(ctx context.Context, req *types.ZSetZPopMaxRequest) (*types.ZSetZPopMaxData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	// Call DAO layer
+	members, err := conn.ZSetZPopMax(ctx, req.Key, req.Count)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetPopFailed)
+	}
+
+	return &types.ZSetZPopMaxData{Members: codec.encodeMembers(members)}, nil
+}
+
+// BZPopMin pops the member with the lowest score from the first non-empty sorted set, blocking up to the timeout
+func (s *RedisZSetServiceImpl) BZPopMin(ctx context.Context, req *types.ZSetBZPopMinRequest) (*types.ZSetBZPopMinData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "BZPOPMIN", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	timeout := time.Duration(req.Timeout * float64(time.Second))
+	key, member, err := conn.ZSetBZPopMin(ctx, req.Keys, timeout)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetPopFailed)
+	}
+
+	if member != nil {
+		member = &codec.encodeMembers([]types.ZSetMember{*member})[0]
+	}
+	return &types.ZSetBZPopMinData{Key: key, Member: member}, nil
+}
+
+// ZMScore gets the scores of multiple members in a sorted set
+func (s *RedisZSetServiceImpl) ZMScore(ctx context.Context, req *types.ZSetZMScoreRequest) (*types.ZSetZMScoreData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+	members, err := codec.decodeAll(req.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "ZMSCORE"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	scores, err := conn.ZSetZMScore(ctx, req.Key, members)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
+	}
+
+	return &types.ZSetZMScoreData{Scores: scores}, nil
+}
+
+// ZRandMember returns random members from a sorted set
+func (s *RedisZSetServiceImpl) ZRandMember(ctx context.Context, req *types.ZSetZRandMemberRequest) (*types.ZSetZRandMemberData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "ZRANDMEMBER"); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	members, err := conn.ZSetZRandMember(ctx, req.Key, req.Count, req.WithScores)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetQueryFailed)
+	}
+
+	return &types.ZSetZRandMemberData{Members: zsetMembersResult(codec.encodeMembers(members), req.WithScores)}, nil
+}
+
+// ZUnion returns the union of multiple sorted sets
+func (s *RedisZSetServiceImpl) ZUnion(ctx context.Context, req *types.ZSetZUnionRequest) (*types.ZSetZUnionData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "ZUNION"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "ZUNION", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	members, err := conn.ZSetZUnion(ctx, req.Keys, req.Weights, req.Aggregate, req.WithScores)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetCombineFailed)
+	}
+
+	return &types.ZSetZUnionData{Members: zsetMembersResult(codec.encodeMembers(members), req.WithScores)}, nil
+}
+
+// ZUnionStore stores the union of multiple sorted sets in the destination key
+func (s *RedisZSetServiceImpl) ZUnionStore(ctx context.Context, req *types.ZSetZUnionStoreRequest) (*types.ZSetStoreData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "ZUNIONSTORE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	count, err := conn.ZSetZUnionStore(ctx, req.Destination, req.Keys, req.Weights, req.Aggregate)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetCombineFailed)
+	}
+
+	return &types.ZSetStoreData{Count: count}, nil
+}
+
+// ZInter returns the intersection of multiple sorted sets
+func (s *RedisZSetServiceImpl) ZInter(ctx context.Context, req *types.ZSetZInterRequest) (*types.ZSetZInterData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "ZINTER"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "ZINTER", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	members, err := conn.ZSetZInter(ctx, req.Keys, req.Weights, req.Aggregate, req.WithScores)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetCombineFailed)
+	}
+
+	return &types.ZSetZInterData{Members: zsetMembersResult(codec.encodeMembers(members), req.WithScores)}, nil
+}
+
+// ZInterStore stores the intersection of multiple sorted sets in the destination key
+func (s *RedisZSetServiceImpl) ZInterStore(ctx context.Context, req *types.ZSetZInterStoreRequest) (*types.ZSetStoreData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkColocated(conn, "ZINTERSTORE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	count, err := conn.ZSetZInterStore(ctx, req.Destination, req.Keys, req.Weights, req.Aggregate)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetCombineFailed)
+	}
+
+	return &types.ZSetStoreData{Count: count}, nil
+}
+
+// ZDiff returns the members of the first sorted set that are not in the others
+func (s *RedisZSetServiceImpl) ZDiff(ctx context.Context, req *types.ZSetZDiffRequest) (*types.ZSetZDiffData, error) {
+	codec, err := newValueCodec(req.ValueEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to Redis
+	conn, err := s.redisDAO.ConnectRead(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "ZDIFF"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "ZDIFF", req.Keys...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	members, err := conn.ZSetZDiff(ctx, req.Keys, req.WithScores)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetCombineFailed)
+	}
+
+	return &types.ZSetZDiffData{Members: zsetMembersResult(codec.encodeMembers(members), req.WithScores)}, nil
+}
+
+// ZDiffStore stores the difference of multiple sorted sets in the destination key
+func (s *RedisZSetServiceImpl) ZDiffStore(ctx context.Context, req *types.ZSetZDiffStoreRequest) (*types.ZSetStoreData, error) {
+	// Connect to Redis
+	conn, err := s.redisDAO.Connect(ctx, req.RedisRequest)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	defer conn.Close()
+
+	if err := checkCommandSupported(ctx, conn, "ZDIFFSTORE"); err != nil {
+		return nil, err
+	}
+
+	if err := checkColocated(conn, "ZDIFFSTORE", append([]string{req.Destination}, req.Keys...)...); err != nil {
+		return nil, err
+	}
+
+	// Call DAO layer
+	count, err := conn.ZSetZDiffStore(ctx, req.Destination, req.Keys)
+	if err != nil {
+		return nil, redisError(err, errors.CodeZSetCombineFailed)
+	}
+
+	return &types.ZSetStoreData{Count: count}, nil
+}
+
+// zsetMembersResult returns scored members as-is, or only their names when scores were not requested
+func zsetMembersResult(members []types.ZSetMember, withScores bool) interface{} {
+	if withScores {
+		return members
+	}
+	names := make([]string, len(members))
+	for i, m := range members {
+		names[i] = m.Member
+	}
+	return names
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/internal/config"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// registeredScript is a named Lua script known to the proxy
+type registeredScript struct {
+	name    string
+	source  string
+	sha     string
+	enabled bool
+	origin  string
+}
+
+// ScriptRegistry holds the named scripts callers may run by name.
+// Scripts come from the configuration and from <name>.lua files in the
+// configured directory; the SHA1 of each script is computed up front so it
+// can be invoked with EVALSHA without a round trip.
+type ScriptRegistry struct {
+	scripts map[string]*registeredScript
+}
+
+// NewScriptRegistry loads the named scripts described by cfg.
+// A missing script directory is not an error.
+func NewScriptRegistry(cfg config.ScriptConfig) (*ScriptRegistry, error) {
+	registry := &ScriptRegistry{
+		scripts: make(map[string]*registeredScript),
+	}
+
+	for _, script := range cfg.Scripts {
+		if err := registry.add(script.Name, script.Source, "config", !script.Disabled); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Dir != "" {
+		paths, err := filepath.Glob(filepath.Join(cfg.Dir, "*.lua"))
+		if err != nil {
+			return nil, fmt.Errorf("scan script dir %s: %w", cfg.Dir, err)
+		}
+		for _, path := range paths {
+			source, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("read script %s: %w", path, err)
+			}
+			name := strings.TrimSuffix(filepath.Base(path), ".lua")
+			if err := registry.add(name, string(source), path, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, name := range cfg.Disabled {
+		if script, ok := registry.scripts[name]; ok {
+			script.enabled = false
+		}
+	}
+
+	return registry, nil
+}
+
+// add registers a script, rejecting empty and duplicate names
+func (r *ScriptRegistry) add(name, source, origin string, enabled bool) error {
+	if name == "" || strings.TrimSpace(source) == "" {
+		return fmt.Errorf("script from %s must have a name and a body", origin)
+	}
+	if existing, ok := r.scripts[name]; ok {
+		return fmt.Errorf("script %s defined twice (%s, %s)", name, existing.origin, origin)
+	}
+
+	sum := sha1.Sum([]byte(source))
+	r.scripts[name] = &registeredScript{
+		name:    name,
+		source:  source,
+		sha:     hex.EncodeToString(sum[:]),
+		enabled: enabled,
+		origin:  origin,
+	}
+	return nil
+}
+
+// get looks up a script by name
+func (r *ScriptRegistry) get(name string) (*registeredScript, bool) {
+	script, ok := r.scripts[name]
+	return script, ok
+}
+
+// List returns the registered scripts sorted by name
+func (r *ScriptRegistry) List() []types.ScriptInfo {
+	list := make([]types.ScriptInfo, 0, len(r.scripts))
+	for _, script := range r.scripts {
+		list = append(list, types.ScriptInfo{
+			Name:    script.name,
+			SHA:     script.sha,
+			Enabled: script.enabled,
+			Origin:  script.origin,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/ct-zh/go-redis-proxy/pkg/errors"
+	"github.com/ct-zh/go-redis-proxy/pkg/types"
+)
+
+// valueCodec converts values, members and fields between the encodings of a request
+// and the raw bytes stored in Redis. Keys are never encoded, hash fields are kept
+// as-is in json mode.
+type valueCodec struct {
+	request  string
+	response string
+	path     []jsonPathSegment
+}
+
+// newValueCodec validates the encodings of a request, the response encoding defaults to the request encoding
+func newValueCodec(enc types.ValueEncoding) (valueCodec, error) {
+	codec := resolveEncodings(enc)
+	for _, encoding := range []string{codec.request, codec.response} {
+		switch encoding {
+		case types.EncodingUTF8, types.EncodingBase64, types.EncodingHex:
+		case types.EncodingJSON:
+			return valueCodec{}, errors.NewError(errors.CodeInvalidParams).
+				WithDetails("encoding json is only supported by GET, MGET, SET, HSET, HGET, HMGET and HGETALL")
+		default:
+			return valueCodec{}, errors.NewError(errors.CodeInvalidParams).
+				WithDetails("unknown encoding %q, must be utf8, base64, hex or json", encoding)
+		}
+	}
+	return codec, nil
+}
+
+// newJSONValueCodec validates the encodings of an operation that also accepts json values,
+// jsonPath selects part of each returned document and requires the json response encoding
+func newJSONValueCodec(enc types.ValueEncoding, jsonPath string) (valueCodec, error) {
+	codec := resolveEncodings(enc)
+	for _, encoding := range []string{codec.request, codec.response} {
+		switch encoding {
+		case types.EncodingUTF8, types.EncodingBase64, types.EncodingHex, types.EncodingJSON:
+		default:
+			return valueCodec{}, errors.NewError(errors.CodeInvalidParams).
+				WithDetails("unknown encoding %q, must be utf8, base64, hex or json", encoding)
+		}
+	}
+	if jsonPath == "" {
+		return codec, nil
+	}
+	if codec.response != types.EncodingJSON {
+		return valueCodec{}, errors.NewError(errors.CodeInvalidParams).
+			WithDetails("json_path requires the json response encoding")
+	}
+	path, err := parseJSONPath(jsonPath)
+	if err != nil {
+		return valueCodec{}, errors.NewError(errors.CodeInvalidParams).WithDetails("invalid json_path %q: %v", jsonPath, err)
+	}
+	codec.path = path
+	return codec, nil
+}
+
+// resolveEncodings applies the defaults of a request's encodings
+func resolveEncodings(enc types.ValueEncoding) valueCodec {
+	codec := valueCodec{request: enc.Encoding, response: enc.ResponseEncoding}
+	if codec.request == "" {
+		codec.request = types.EncodingUTF8
+	}
+	if codec.response == "" {
+		codec.response = codec.request
+	}
+	return codec
+}
+
+// decode returns the raw bytes of a request value
+func (c valueCodec) decode(value string) (string, error) {
+	var raw []byte
+	var err error
+	switch c.request {
+	case types.EncodingBase64:
+		raw, err = base64.StdEncoding.DecodeString(value)
+	case types.EncodingHex:
+		raw, err = hex.DecodeString(value)
+	default:
+		return value, nil
+	}
+	if err != nil {
+		return "", errors.NewError(errors.CodeInvalidParams).WithDetails("value is not valid %s: %v", c.request, err)
+	}
+	return string(raw), nil
+}
+
+// decodeAll decodes a list of request values
+func (c valueCodec) decodeAll(values []string) ([]string, error) {
+	if c.request == types.EncodingUTF8 {
+		return values, nil
+	}
+	decoded := make([]string, len(values))
+	for i, value := range values {
+		raw, err := c.decode(value)
+		if err != nil {
+			return nil, err
+		}
+		decoded[i] = raw
+	}
+	return decoded, nil
+}
+
+// decodeLexBound decodes the member of a ZRANGEBYLEX style bound, keeping the [ or ( prefix; - and + are kept as-is
+func (c valueCodec) decodeLexBound(bound string) (string, error) {
+	if c.request == types.EncodingUTF8 || bound == "-" || bound == "+" || bound == "" {
+		return bound, nil
+	}
+	if !strings.HasPrefix(bound, "[") && !strings.HasPrefix(bound, "(") {
+		return bound, nil
+	}
+	member, err := c.decode(bound[1:])
+	if err != nil {
+		return "", err
+	}
+	return bound[:1] + member, nil
+}
+
+// encode returns a raw Redis value in the response encoding
+func (c valueCodec) encode(raw string) string {
+	switch c.response {
+	case types.EncodingBase64:
+		return base64.StdEncoding.EncodeToString([]byte(raw))
+	case types.EncodingHex:
+		return hex.EncodeToString([]byte(raw))
+	}
+	return raw
+}
+
+// encodeAll encodes a list of raw Redis values
+func (c valueCodec) encodeAll(raws []string) []string {
+	if c.response == types.EncodingUTF8 {
+		return raws
+	}
+	encoded := make([]string, len(raws))
+	for i, raw := range raws {
+		encoded[i] = c.encode(raw)
+	}
+	return encoded
+}
+
+// encodeValue encodes a string result, nil and numbers such as scores are returned unchanged
+func (c valueCodec) encodeValue(v interface{}) interface{} {
+	if raw, ok := v.(string); ok {
+		return c.encode(raw)
+	}
+	return v
+}
+
+// encodeValues encodes the string elements of a mixed result list
+func (c valueCodec) encodeValues(values []interface{}) []interface{} {
+	if c.response == types.EncodingUTF8 {
+		return values
+	}
+	encoded := make([]interface{}, len(values))
+	for i, v := range values {
+		encoded[i] = c.encodeValue(v)
+	}
+	return encoded
+}
+
+// encodeMembers encodes the member names of scored members
+func (c valueCodec) encodeMembers(members []types.ZSetMember) []types.ZSetMember {
+	if c.response == types.EncodingUTF8 {
+		return members
+	}
+	encoded := make([]types.ZSetMember, len(members))
+	for i, m := range members {
+		encoded[i] = types.ZSetMember{Member: c.encode(m.Member), Score: m.Score}
+	}
+	return encoded
+}
+
+// decodeValue returns the raw bytes of a request value, json values are validated and stored canonically
+func (c valueCodec) decodeValue(value types.Value) (string, error) {
+	if c.request == types.EncodingJSON {
+		canonical, err := canonicalJSON(value.Raw())
+		if err != nil {
+			return "", errors.NewError(errors.CodeInvalidParams).WithDetails("value is not valid json: %v", err)
+		}
+		return canonical, nil
+	}
+	text, err := value.Text()
+	if err != nil {
+		return "", errors.NewError(errors.CodeInvalidParams).WithDetails("%v", err)
+	}
+	return c.decode(text)
+}
+
+// decodeFieldValues decodes both the fields and the values of a hash field-value map
+func (c valueCodec) decodeFieldValues(fields map[string]types.Value) (map[string]string, error) {
+	decoded := make(map[string]string, len(fields))
+	for field, value := range fields {
+		rawField, err := c.decode(field)
+		if err != nil {
+			return nil, err
+		}
+		rawValue, err := c.decodeValue(value)
+		if err != nil {
+			return nil, err
+		}
+		decoded[rawField] = rawValue
+	}
+	return decoded, nil
+}
+
+// encodeDocument encodes a string result, in json mode the stored document is parsed and
+// the json path applied; ok is false when the stored value is not valid JSON
+func (c valueCodec) encodeDocument(v interface{}) (interface{}, bool) {
+	if c.response != types.EncodingJSON {
+		return c.encodeValue(v), true
+	}
+	raw, isString := v.(string)
+	if !isString {
+		return v, true
+	}
+	doc, err := parseJSONDocument(raw)
+	if err != nil {
+		return nil, false
+	}
+	return selectJSONPath(doc, c.path), true
+}
+
+// encodeDocuments encodes a mixed result list, invalid is the index of the first value that is not valid JSON or -1
+func (c valueCodec) encodeDocuments(values []interface{}) (encoded []interface{}, invalid int) {
+	if c.response != types.EncodingJSON {
+		return c.encodeValues(values), -1
+	}
+	encoded = make([]interface{}, len(values))
+	for i, v := range values {
+		doc, ok := c.encodeDocument(v)
+		if !ok {
+			return nil, i
+		}
+		encoded[i] = doc
+	}
+	return encoded, -1
+}
+
+// encodeFieldDocuments encodes both the fields and the values of a hash field-value map,
+// invalid is a field whose value is not valid JSON
+func (c valueCodec) encodeFieldDocuments(fields map[string]string) (encoded map[string]interface{}, invalid string, ok bool) {
+	encoded = make(map[string]interface{}, len(fields))
+	for field, value := range fields {
+		doc, valid := c.encodeDocument(value)
+		if !valid {
+			return nil, field, false
+		}
+		encoded[c.encode(field)] = doc
+	}
+	return encoded, "", true
+}
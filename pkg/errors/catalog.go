@@ -0,0 +1,220 @@
+package errors
+
+import (
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// codesSource 错误码常量的定义，用于检查常量是否都已注册、是否在所属模块的号段内
+//
+//go:embed codes.go
+var codesSource embed.FS
+
+// rangePattern 匹配常量块注释中的模块说明和号段，如"String操作错误 2100-2199"
+var rangePattern = regexp.MustCompile(`^(.+?)\s+(\d+)-(\d+)$`)
+
+// codeConst codes.go中的一个错误码常量
+type codeConst struct {
+	name  string
+	value int
+}
+
+// codeBlock codes.go中的一个常量块，对应一个模块的号段
+type codeBlock struct {
+	description string
+	min, max    int
+	consts      []codeConst
+}
+
+// loadCodeBlocks 解析codes.go中的常量块，只解析一次
+var loadCodeBlocks = sync.OnceValues(func() ([]codeBlock, error) {
+	src, err := codesSource.ReadFile("codes.go")
+	if err != nil {
+		return nil, err
+	}
+	return parseCodeBlocks(src)
+})
+
+// ModuleInfo 错误码模块及其号段
+type ModuleInfo struct {
+	Module      string `json:"module" example:"string"`
+	Description string `json:"description" example:"String操作错误"`
+	Min         int    `json:"min" example:"2100"`
+	Max         int    `json:"max" example:"2199"`
+}
+
+// Catalog 错误码目录，按错误码排序
+type Catalog struct {
+	Modules []ModuleInfo `json:"modules"`
+	Errors  []ErrorInfo  `json:"errors"`
+	Locales []string     `json:"locales" example:"zh,en"` // 注册时使用的中文在前
+}
+
+// parseCodeBlocks 解析错误码常量块，每个块的注释最后一行必须是"模块说明 起始-结束"
+func parseCodeBlocks(src []byte) ([]codeBlock, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "codes.go", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []codeBlock
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		var block codeBlock
+		doc := strings.Split(strings.TrimSpace(gen.Doc.Text()), "\n")
+		match := rangePattern.FindStringSubmatch(doc[len(doc)-1])
+		if match == nil {
+			return nil, fmt.Errorf("const block at line %d has no code range comment", lineOf(src, gen.Pos()))
+		}
+		block.description = match[1]
+		block.min, _ = strconv.Atoi(match[2])
+		block.max, _ = strconv.Atoi(match[3])
+
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			for i, name := range value.Names {
+				if i >= len(value.Values) {
+					return nil, fmt.Errorf("error code %s must have an explicit value", name.Name)
+				}
+				lit, ok := value.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.INT {
+					return nil, fmt.Errorf("error code %s must be an integer literal", name.Name)
+				}
+				code, err := strconv.Atoi(lit.Value)
+				if err != nil {
+					return nil, fmt.Errorf("error code %s: %w", name.Name, err)
+				}
+				block.consts = append(block.consts, codeConst{name: name.Name, value: code})
+			}
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// lineOf 返回源码位置所在的行号
+func lineOf(src []byte, pos token.Pos) int {
+	return strings.Count(string(src[:int(pos)-1]), "\n") + 1
+}
+
+// checkCodes 检查codes.go中的每个常量都已注册、在所属块的号段内，
+// 且同一块的常量注册在同一模块，号段之间不重叠
+func (r *ErrorRegistry) checkCodes(blocks []codeBlock) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var problems []string
+	for i, block := range blocks {
+		for _, other := range blocks[:i] {
+			if block.min <= other.max && other.min <= block.max {
+				problems = append(problems, fmt.Sprintf("range %d-%d of %s overlaps %d-%d of %s",
+					block.min, block.max, block.description, other.min, other.max, other.description))
+			}
+		}
+
+		module := ""
+		for _, c := range block.consts {
+			if c.value < block.min || c.value > block.max {
+				problems = append(problems, fmt.Sprintf("%s = %d is outside the range %d-%d of %s",
+					c.name, c.value, block.min, block.max, block.description))
+			}
+			info, exists := r.errors[c.value]
+			if !exists {
+				problems = append(problems, fmt.Sprintf("%s = %d is not registered", c.name, c.value))
+				continue
+			}
+			if module == "" {
+				module = info.Module
+			} else if info.Module != module {
+				problems = append(problems, fmt.Sprintf("%s = %d is registered in module %s, other codes of %s are in module %s",
+					c.name, c.value, info.Module, block.description, module))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// snapshot 返回按错误码排序的注册信息副本
+func (r *ErrorRegistry) snapshot() []ErrorInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]ErrorInfo, 0, len(r.errors))
+	for _, info := range r.errors {
+		copied := *info
+		copied.Messages = make(map[string]string, len(info.Messages))
+		for locale, message := range info.Messages {
+			copied.Messages[locale] = message
+		}
+		infos = append(infos, copied)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Code < infos[j].Code
+	})
+	return infos
+}
+
+// CheckCodes 检查codes.go中的错误码常量都已注册且在所属模块的号段内
+func (m *ErrorManager) CheckCodes() error {
+	blocks, err := loadCodeBlocks()
+	if err != nil {
+		return fmt.Errorf("parse codes.go: %w", err)
+	}
+	return m.registry.checkCodes(blocks)
+}
+
+// Catalog 返回所有已注册错误码及模块号段
+func (m *ErrorManager) Catalog() (Catalog, error) {
+	blocks, err := loadCodeBlocks()
+	if err != nil {
+		return Catalog{}, fmt.Errorf("parse codes.go: %w", err)
+	}
+
+	catalog := Catalog{
+		Modules: make([]ModuleInfo, 0, len(blocks)),
+		Errors:  m.registry.snapshot(),
+		Locales: []string{LocaleZH},
+	}
+	for _, locale := range m.registry.Locales() {
+		if locale != LocaleZH {
+			catalog.Locales = append(catalog.Locales, locale)
+		}
+	}
+	for _, block := range blocks {
+		module := ""
+		for _, c := range block.consts {
+			if info, exists := m.registry.Get(c.value); exists {
+				module = info.Module
+				break
+			}
+		}
+		catalog.Modules = append(catalog.Modules, ModuleInfo{
+			Module:      module,
+			Description: block.description,
+			Min:         block.min,
+			Max:         block.max,
+		})
+	}
+	return catalog, nil
+}
+
+// GetCatalog 返回错误码目录（使用全局管理器）
+func GetCatalog() (Catalog, error) {
+	return GetGlobalManager().Catalog()
+}
@@ -8,14 +8,24 @@ const (
 	CodeInvalidParams = 1001 // 参数验证失败
 	CodeMethodNotAllowed = 1002 // 方法不允许
 	CodeUnauthorized = 1003 // 未授权访问
+	CodeForbidden = 1004 // 身份无权访问该路由组
+	CodeRateLimited = 1005 // 请求超出限流
 )
 
 // Redis连接错误 2000-2099
 const (
-	CodeRedisConnectFailed = 2000 // Redis连接失败
-	CodeRedisTimeout       = 2001 // Redis操作超时
-	CodeRedisAuthFailed    = 2002 // Redis认证失败
-	CodeRedisDBSelectFailed = 2003 // Redis数据库选择失败
+	CodeRedisConnectFailed      = 2000 // Redis连接失败
+	CodeRedisTimeout            = 2001 // Redis操作超时
+	CodeRedisAuthFailed         = 2002 // Redis认证失败
+	CodeRedisDBSelectFailed     = 2003 // Redis数据库选择失败
+	CodeRedisCommandUnsupported = 2004 // Redis服务器不支持该命令
+	CodeRedisTargetOverloaded   = 2005 // Redis目标过载
+	CodeRedisOutOfMemory        = 2006 // Redis内存不足，拒绝写入
+	CodeRedisReadOnly           = 2007 // Redis节点只读
+	CodeRedisBusy               = 2008 // Redis正在执行脚本或函数
+	CodeRedisLoading            = 2009 // Redis正在加载数据
+	CodeRedisClusterRedirect    = 2010 // 集群哈希槽已迁移
+	CodeRedisClusterDown        = 2011 // 集群不可用
 )
 
 // String操作错误 2100-2199
@@ -28,6 +38,7 @@ const (
 	CodeStringIncrFailed    = 2105 // 自增失败
 	CodeStringDecrFailed    = 2106 // 自减失败
 	CodeStringExpireFailed  = 2107 // 设置过期时间失败
+	CodeStringInvalidJSON   = 2108 // 存储的值不是合法的JSON
 )
 
 // List操作错误 2200-2299
@@ -38,6 +49,7 @@ const (
 	CodeListPopFailed       = 2203 // 弹出失败
 	CodeListRemoveFailed    = 2204 // 删除失败
 	CodeListTrimFailed      = 2205 // 裁剪失败
+	CodeListQueryFailed     = 2206 // 查询失败
 )
 
 // Hash操作错误 2300-2399
@@ -50,6 +62,8 @@ const (
 	CodeHashDelFailed       = 2305 // 删除失败
 	CodeHashDeleteFailed    = 2306 // 删除失败（alias）
 	CodeHashIncrementFailed = 2307 // 增量操作失败
+	CodeHashExpireFailed    = 2308 // 字段过期时间设置失败
+	CodeHashInvalidJSON     = 2309 // 字段值不是合法的JSON
 )
 
 // Set操作错误 2400-2499
@@ -58,6 +72,10 @@ const (
 	CodeSetAddFailed        = 2401 // 添加失败
 	CodeSetRemoveFailed     = 2402 // 删除失败
 	CodeSetMemberNotFound   = 2403 // 成员不存在
+	CodeSetAlgebraFailed    = 2404 // 集合运算失败
+	CodeSetMoveFailed       = 2405 // 移动成员失败
+	CodeSetPopFailed        = 2406 // 弹出成员失败
+	CodeSetQueryFailed      = 2407 // 查询失败
 )
 
 // ZSet操作错误 2500-2599
@@ -67,4 +85,63 @@ const (
 	CodeZSetRemoveFailed    = 2502 // 删除失败
 	CodeZSetMemberNotFound  = 2503 // 成员不存在
 	CodeZSetRankNotFound    = 2504 // 排名不存在
-)
\ No newline at end of file
+	CodeZSetQueryFailed     = 2505 // 查询失败
+	CodeZSetPopFailed       = 2506 // 弹出失败
+	CodeZSetCombineFailed   = 2507 // 集合运算失败
+)
+
+// Bitmap操作错误 2600-2699
+const (
+	CodeBitmapTypeMismatch  = 2600 // 类型不匹配
+	CodeBitmapSetFailed     = 2601 // 设置位失败
+	CodeBitmapGetFailed     = 2602 // 获取位失败
+	CodeBitmapQueryFailed   = 2603 // 位统计查询失败
+	CodeBitmapOpFailed      = 2604 // 位运算失败
+	CodeBitmapFieldFailed   = 2605 // 位域操作失败
+)
+
+// HyperLogLog操作错误 2700-2799
+const (
+	CodeHLLTypeMismatch     = 2700 // 类型不匹配
+	CodeHLLAddFailed        = 2701 // 添加元素失败
+	CodeHLLCountFailed      = 2702 // 基数统计失败
+	CodeHLLMergeFailed      = 2703 // 合并失败
+)
+
+// Geo操作错误 2800-2899
+const (
+	CodeGeoTypeMismatch     = 2800 // 类型不匹配
+	CodeGeoAddFailed        = 2801 // 添加位置失败
+	CodeGeoQueryFailed      = 2802 // 查询位置失败
+	CodeGeoSearchFailed     = 2803 // 范围搜索失败
+)
+
+// Script操作错误 2900-2999
+const (
+	CodeScriptEvalDisabled  = 2900 // 临时脚本已禁用
+	CodeScriptNotFound      = 2901 // 命名脚本不存在
+	CodeScriptDisabled      = 2902 // 命名脚本已禁用
+	CodeScriptExecFailed    = 2903 // 脚本执行失败
+	CodeScriptLoadFailed    = 2904 // 脚本加载失败
+	CodeScriptAdminFailed   = 2905 // 脚本管理操作失败
+)
+
+// Function操作错误 3000-3099
+const (
+	CodeFunctionLoadFailed    = 3000 // 函数库加载失败
+	CodeFunctionAdminFailed   = 3001 // 函数库管理操作失败
+	CodeFunctionDumpFailed    = 3002 // 函数库导出失败
+	CodeFunctionRestoreFailed = 3003 // 函数库恢复失败
+	CodeFunctionCallFailed    = 3004 // 函数调用失败
+)
+
+// Cluster操作错误 3100-3199
+const (
+	CodeClusterCrossSlot = 3100 // 键不在同一哈希槽或分片
+)
+
+// Pipeline操作错误 3200-3299
+const (
+	CodePipelineCommandNotAllowed = 3200 // 命令不允许在Pipeline中执行
+	CodePipelineFailed            = 3201 // Pipeline执行失败
+)
@@ -0,0 +1,191 @@
+package errors
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 内置语言
+const (
+	LocaleZH = "zh" // 注册错误码时使用的语言
+	LocaleEN = "en"
+)
+
+// builtinCatalogs 内置的消息目录，文件名为语言标签
+//
+//go:embed locales/*.yaml
+var builtinCatalogs embed.FS
+
+// verbPattern 匹配消息模板中的格式化占位符
+var verbPattern = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+
+// AddMessages 添加一种语言的消息，覆盖该语言已有的消息。
+// 错误码必须已注册，消息中的占位符必须与注册时的中文消息顺序和类型一致，
+// 否则整批消息都不会添加。
+func (r *ErrorRegistry) AddMessages(locale string, messages map[int]string) error {
+	locale = normalizeLocale(locale)
+	if locale == "" {
+		return fmt.Errorf("locale is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for code, message := range messages {
+		info, exists := r.errors[code]
+		if !exists {
+			return fmt.Errorf("locale %s: error code %d is not registered", locale, code)
+		}
+		if want, got := placeholders(info.Message), placeholders(message); want != got {
+			return fmt.Errorf("locale %s: message of error code %d has placeholders %q, want %q", locale, code, got, want)
+		}
+	}
+	for code, message := range messages {
+		r.errors[code].Messages[locale] = message
+	}
+	r.locales[locale] = true
+	return nil
+}
+
+// Message 返回错误码在指定语言下的消息模板。
+// 没有该语言的消息时依次使用主语言（如en-us使用en）、英文和中文的消息。
+func (r *ErrorRegistry) Message(code int, locale string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, exists := r.errors[code]
+	if !exists {
+		return "", false
+	}
+	for _, candidate := range fallbackLocales(locale) {
+		if message, ok := info.Messages[candidate]; ok {
+			return message, true
+		}
+	}
+	return info.Message, true
+}
+
+// MatchLocale 返回与语言标签匹配的已支持语言，如en-US匹配en
+func (r *ErrorRegistry) MatchLocale(tag string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tag = normalizeLocale(tag)
+	if r.locales[tag] {
+		return tag, true
+	}
+	if primary, _, ok := strings.Cut(tag, "-"); ok && r.locales[primary] {
+		return primary, true
+	}
+	return "", false
+}
+
+// Locales 返回已支持的语言
+func (r *ErrorRegistry) Locales() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	locales := make([]string, 0, len(r.locales))
+	for locale := range r.locales {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// LoadCatalog 加载一种语言的消息目录，内容为错误码到消息的YAML映射
+func (m *ErrorManager) LoadCatalog(locale string, data []byte) error {
+	var messages map[int]string
+	if err := yaml.Unmarshal(data, &messages); err != nil {
+		return fmt.Errorf("parse %s catalog: %w", locale, err)
+	}
+	return m.registry.AddMessages(locale, messages)
+}
+
+// LoadCatalogDir 加载目录中的消息目录文件，文件名为语言标签，如ja.yaml、en-GB.yaml
+func (m *ErrorManager) LoadCatalogDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.y*ml"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read catalog: %w", err)
+		}
+		locale := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if err := m.LoadCatalog(locale, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchLocale 返回与语言标签匹配的已支持语言
+func (m *ErrorManager) MatchLocale(tag string) (string, bool) {
+	return m.registry.MatchLocale(tag)
+}
+
+// loadBuiltinCatalogs 加载内置的消息目录
+func (m *ErrorManager) loadBuiltinCatalogs() {
+	paths, _ := builtinCatalogs.ReadDir("locales")
+	for _, entry := range paths {
+		data, err := builtinCatalogs.ReadFile("locales/" + entry.Name())
+		if err == nil {
+			err = m.LoadCatalog(strings.TrimSuffix(entry.Name(), ".yaml"), data)
+		}
+		if err != nil {
+			// 内置目录随代码发布，出错说明消息与注册的错误码不一致
+			panic(fmt.Sprintf("builtin message catalog %s: %v", entry.Name(), err))
+		}
+	}
+}
+
+// LoadCatalogs 加载目录中的消息目录文件（使用全局管理器），目录为空时不加载
+func LoadCatalogs(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	return GetGlobalManager().LoadCatalogDir(dir)
+}
+
+// MatchLocale 返回与语言标签匹配的已支持语言（使用全局管理器）
+func MatchLocale(tag string) (string, bool) {
+	return GetGlobalManager().MatchLocale(tag)
+}
+
+// normalizeLocale 统一语言标签的写法，如zh_CN、ZH-cn都转为zh-cn
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// fallbackLocales 返回查找消息时依次尝试的语言
+func fallbackLocales(locale string) []string {
+	locale = normalizeLocale(locale)
+	chain := make([]string, 0, 4)
+	if locale != "" {
+		chain = append(chain, locale)
+		if primary, _, ok := strings.Cut(locale, "-"); ok {
+			chain = append(chain, primary)
+		}
+	}
+	return append(chain, LocaleEN, LocaleZH)
+}
+
+// placeholders 返回消息模板中的格式化占位符，%%不计入
+func placeholders(template string) string {
+	var verbs []string
+	for _, verb := range verbPattern.FindAllString(template, -1) {
+		if verb != "%%" {
+			verbs = append(verbs, verb)
+		}
+	}
+	return strings.Join(verbs, " ")
+}
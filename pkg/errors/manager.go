@@ -43,12 +43,22 @@ func (m *ErrorManager) initPredefinedErrors() {
 	m.registry.Register(CodeInvalidParams, "参数验证失败", "system")
 	m.registry.Register(CodeMethodNotAllowed, "方法不允许", "system")
 	m.registry.Register(CodeUnauthorized, "未授权访问", "system")
+	m.registry.Register(CodeForbidden, "无权访问%s接口", "system")
+	m.registry.Register(CodeRateLimited, "请求过于频繁，请在%d秒后重试", "system")
 	
 	// Redis连接错误
 	m.registry.Register(CodeRedisConnectFailed, "Redis连接失败", "redis")
 	m.registry.Register(CodeRedisTimeout, "Redis操作超时", "redis")
 	m.registry.Register(CodeRedisAuthFailed, "Redis认证失败", "redis")
 	m.registry.Register(CodeRedisDBSelectFailed, "Redis数据库选择失败", "redis")
+	m.registry.Register(CodeRedisCommandUnsupported, "Redis服务器不支持%s命令（当前版本%s，需要%s及以上）", "redis")
+	m.registry.Register(CodeRedisTargetOverloaded, "Redis目标%s过载，请稍后重试", "redis")
+	m.registry.Register(CodeRedisOutOfMemory, "Redis内存已达上限，拒绝写入", "redis")
+	m.registry.Register(CodeRedisReadOnly, "Redis节点为只读副本，无法执行写命令", "redis")
+	m.registry.Register(CodeRedisBusy, "Redis正在执行脚本或函数，请稍后重试", "redis")
+	m.registry.Register(CodeRedisLoading, "Redis正在加载数据，请稍后重试", "redis")
+	m.registry.Register(CodeRedisClusterRedirect, "集群哈希槽正在迁移，请稍后重试", "redis")
+	m.registry.Register(CodeRedisClusterDown, "Redis集群不可用", "redis")
 	
 	// String操作错误
 	m.registry.Register(CodeStringKeyNotFound, "键不存在", "string")
@@ -59,6 +69,7 @@ func (m *ErrorManager) initPredefinedErrors() {
 	m.registry.Register(CodeStringIncrFailed, "自增失败", "string")
 	m.registry.Register(CodeStringDecrFailed, "自减失败", "string")
 	m.registry.Register(CodeStringExpireFailed, "设置过期时间失败", "string")
+	m.registry.Register(CodeStringInvalidJSON, "存储的值不是合法的JSON", "string")
 	
 	// List操作错误
 	m.registry.Register(CodeListIndexOutOfRange, "索引超出范围", "list")
@@ -67,6 +78,7 @@ func (m *ErrorManager) initPredefinedErrors() {
 	m.registry.Register(CodeListPopFailed, "弹出失败", "list")
 	m.registry.Register(CodeListRemoveFailed, "删除失败", "list")
 	m.registry.Register(CodeListTrimFailed, "裁剪失败", "list")
+	m.registry.Register(CodeListQueryFailed, "查询失败", "list")
 	
 	// Hash操作错误
 	m.registry.Register(CodeHashKeyNotFound, "Hash键不存在", "hash")
@@ -77,12 +89,18 @@ func (m *ErrorManager) initPredefinedErrors() {
 	m.registry.Register(CodeHashDelFailed, "删除失败", "hash")
 	m.registry.Register(CodeHashDeleteFailed, "删除失败", "hash")
 	m.registry.Register(CodeHashIncrementFailed, "增量操作失败", "hash")
+	m.registry.Register(CodeHashExpireFailed, "字段过期时间设置失败", "hash")
+	m.registry.Register(CodeHashInvalidJSON, "字段值不是合法的JSON", "hash")
 	
 	// Set操作错误
 	m.registry.Register(CodeSetTypeMismatch, "类型不匹配", "set")
 	m.registry.Register(CodeSetAddFailed, "添加失败", "set")
 	m.registry.Register(CodeSetRemoveFailed, "删除失败", "set")
 	m.registry.Register(CodeSetMemberNotFound, "成员不存在", "set")
+	m.registry.Register(CodeSetAlgebraFailed, "集合运算失败", "set")
+	m.registry.Register(CodeSetMoveFailed, "移动成员失败", "set")
+	m.registry.Register(CodeSetPopFailed, "弹出成员失败", "set")
+	m.registry.Register(CodeSetQueryFailed, "查询失败", "set")
 	
 	// ZSet操作错误
 	m.registry.Register(CodeZSetTypeMismatch, "类型不匹配", "zset")
@@ -90,6 +108,54 @@ func (m *ErrorManager) initPredefinedErrors() {
 	m.registry.Register(CodeZSetRemoveFailed, "删除失败", "zset")
 	m.registry.Register(CodeZSetMemberNotFound, "成员不存在", "zset")
 	m.registry.Register(CodeZSetRankNotFound, "排名不存在", "zset")
+	m.registry.Register(CodeZSetQueryFailed, "查询失败", "zset")
+	m.registry.Register(CodeZSetPopFailed, "弹出失败", "zset")
+	m.registry.Register(CodeZSetCombineFailed, "集合运算失败", "zset")
+	
+	// Bitmap操作错误
+	m.registry.Register(CodeBitmapTypeMismatch, "类型不匹配", "bitmap")
+	m.registry.Register(CodeBitmapSetFailed, "设置位失败", "bitmap")
+	m.registry.Register(CodeBitmapGetFailed, "获取位失败", "bitmap")
+	m.registry.Register(CodeBitmapQueryFailed, "位统计查询失败", "bitmap")
+	m.registry.Register(CodeBitmapOpFailed, "位运算失败", "bitmap")
+	m.registry.Register(CodeBitmapFieldFailed, "位域操作失败", "bitmap")
+	
+	// HyperLogLog操作错误
+	m.registry.Register(CodeHLLTypeMismatch, "类型不匹配", "hll")
+	m.registry.Register(CodeHLLAddFailed, "添加元素失败", "hll")
+	m.registry.Register(CodeHLLCountFailed, "基数统计失败", "hll")
+	m.registry.Register(CodeHLLMergeFailed, "合并失败", "hll")
+	
+	// Geo操作错误
+	m.registry.Register(CodeGeoTypeMismatch, "类型不匹配", "geo")
+	m.registry.Register(CodeGeoAddFailed, "添加位置失败", "geo")
+	m.registry.Register(CodeGeoQueryFailed, "查询位置失败", "geo")
+	m.registry.Register(CodeGeoSearchFailed, "范围搜索失败", "geo")
+	
+	// Script操作错误
+	m.registry.Register(CodeScriptEvalDisabled, "临时脚本执行已禁用，请使用命名脚本", "script")
+	m.registry.Register(CodeScriptNotFound, "命名脚本%s不存在", "script")
+	m.registry.Register(CodeScriptDisabled, "命名脚本%s已禁用", "script")
+	m.registry.Register(CodeScriptExecFailed, "脚本执行失败", "script")
+	m.registry.Register(CodeScriptLoadFailed, "脚本加载失败", "script")
+	m.registry.Register(CodeScriptAdminFailed, "脚本管理操作失败", "script")
+	
+	// Function操作错误
+	m.registry.Register(CodeFunctionLoadFailed, "函数库加载失败", "function")
+	m.registry.Register(CodeFunctionAdminFailed, "函数库管理操作失败", "function")
+	m.registry.Register(CodeFunctionDumpFailed, "函数库导出失败", "function")
+	m.registry.Register(CodeFunctionRestoreFailed, "函数库恢复失败", "function")
+	m.registry.Register(CodeFunctionCallFailed, "函数调用失败", "function")
+	
+	// Cluster操作错误
+	m.registry.Register(CodeClusterCrossSlot, "%s命令的键分布在不同的哈希槽或分片，请使用相同的{hash tag}", "cluster")
+	
+	// Pipeline操作错误
+	m.registry.Register(CodePipelineCommandNotAllowed, "命令%s不允许在Pipeline中执行", "pipeline")
+	m.registry.Register(CodePipelineFailed, "Pipeline执行失败", "pipeline")
+	
+	// 其他语言的消息
+	m.loadBuiltinCatalogs()
 }
 
 // NewBusinessError 创建业务错误
@@ -105,7 +171,19 @@ func (m *ErrorManager) NewBusinessError(code int, args ...interface{}) BusinessE
 		message = fmt.Sprintf(info.Message, args...)
 	}
 	
-	return NewBusinessError(code, message)
+	bizErr := newBusinessError(code, message)
+	bizErr.registry = m.registry
+	bizErr.args = args
+	return bizErr
+}
+
+// WrapBusinessError 创建业务错误并保留底层错误，底层错误只用于日志，不返回给客户端
+func (m *ErrorManager) WrapBusinessError(cause error, code int, args ...interface{}) BusinessError {
+	bizErr := m.NewBusinessError(code, args...)
+	if cause == nil {
+		return bizErr
+	}
+	return bizErr.WithCause(cause)
 }
 
 // Register 注册自定义错误码
@@ -113,9 +191,17 @@ func (m *ErrorManager) Register(code int, message, module string) error {
 	return m.registry.Register(code, message, module)
 }
 
-// Validate 验证错误管理器
+// Validate 验证错误管理器，包括codes.go中的常量是否都已注册且在所属模块的号段内
 func (m *ErrorManager) Validate() error {
-	return m.registry.Validate()
+	if err := m.registry.Validate(); err != nil {
+		return err
+	}
+	return m.CheckCodes()
+}
+
+// GetErrorInfo 获取错误码的注册信息
+func (m *ErrorManager) GetErrorInfo(code int) (*ErrorInfo, bool) {
+	return m.registry.Get(code)
 }
 
 // GetAllErrors 获取所有错误信息
@@ -130,6 +216,16 @@ func NewError(code int, args ...interface{}) BusinessError {
 	return GetGlobalManager().NewBusinessError(code, args...)
 }
 
+// Wrap 创建保留底层错误的业务错误（使用全局管理器）
+func Wrap(cause error, code int, args ...interface{}) BusinessError {
+	return GetGlobalManager().WrapBusinessError(cause, code, args...)
+}
+
+// Lookup 获取错误码的注册信息（使用全局管理器）
+func Lookup(code int) (*ErrorInfo, bool) {
+	return GetGlobalManager().GetErrorInfo(code)
+}
+
 // RegisterError 注册错误码（使用全局管理器）
 func RegisterError(code int, message, module string) error {
 	return GetGlobalManager().Register(code, message, module)
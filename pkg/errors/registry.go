@@ -7,21 +7,28 @@ import (
 
 // ErrorInfo 错误信息结构
 type ErrorInfo struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-	Module  string `json:"module"`
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	Module     string `json:"module"`
+	HTTPStatus int    `json:"http_status"`
+	Retryable  bool   `json:"retryable"`
+
+	// Messages 各语言的消息模板，包括注册时的中文消息
+	Messages map[string]string `json:"messages"`
 }
 
 // ErrorRegistry 错误注册表
 type ErrorRegistry struct {
-	mu     sync.RWMutex
-	errors map[int]*ErrorInfo
+	mu      sync.RWMutex
+	errors  map[int]*ErrorInfo
+	locales map[string]bool // 至少有一条消息的语言
 }
 
 // NewErrorRegistry 创建错误注册表实例
 func NewErrorRegistry() *ErrorRegistry {
 	return &ErrorRegistry{
-		errors: make(map[int]*ErrorInfo),
+		errors:  make(map[int]*ErrorInfo),
+		locales: map[string]bool{LocaleZH: true},
 	}
 }
 
@@ -35,9 +42,12 @@ func (r *ErrorRegistry) Register(code int, message, module string) error {
 	}
 	
 	r.errors[code] = &ErrorInfo{
-		Code:    code,
-		Message: message,
-		Module:  module,
+		Code:       code,
+		Message:    message,
+		Module:     module,
+		HTTPStatus: HTTPStatus(code),
+		Retryable:  IsRetryable(code),
+		Messages:   map[string]string{LocaleZH: message},
 	}
 	
 	return nil
@@ -0,0 +1,84 @@
+package errors
+
+import "net/http"
+
+// httpStatuses 错误码对应的HTTP状态码，未列出的错误码返回500
+var httpStatuses = map[int]int{
+	// 系统级错误
+	CodeInvalidParams:    http.StatusBadRequest,
+	CodeMethodNotAllowed: http.StatusMethodNotAllowed,
+	CodeUnauthorized:     http.StatusUnauthorized,
+	CodeForbidden:        http.StatusForbidden,
+	CodeRateLimited:      http.StatusTooManyRequests,
+
+	// Redis目标不可用或拒绝执行
+	CodeRedisConnectFailed:      http.StatusBadGateway,
+	CodeRedisTimeout:            http.StatusGatewayTimeout,
+	CodeRedisAuthFailed:         http.StatusBadGateway,
+	CodeRedisDBSelectFailed:     http.StatusBadGateway,
+	CodeRedisCommandUnsupported: http.StatusNotImplemented,
+	CodeRedisTargetOverloaded:   http.StatusServiceUnavailable,
+	CodeRedisOutOfMemory:        http.StatusInsufficientStorage,
+	CodeRedisReadOnly:           http.StatusServiceUnavailable,
+	CodeRedisBusy:               http.StatusServiceUnavailable,
+	CodeRedisLoading:            http.StatusServiceUnavailable,
+	CodeRedisClusterRedirect:    http.StatusServiceUnavailable,
+	CodeRedisClusterDown:        http.StatusServiceUnavailable,
+
+	// 键中已有其他类型的数据
+	CodeStringTypeMismatch: http.StatusConflict,
+	CodeListTypeMismatch:   http.StatusConflict,
+	CodeHashTypeMismatch:   http.StatusConflict,
+	CodeSetTypeMismatch:    http.StatusConflict,
+	CodeZSetTypeMismatch:   http.StatusConflict,
+	CodeBitmapTypeMismatch: http.StatusConflict,
+	CodeHLLTypeMismatch:    http.StatusConflict,
+	CodeGeoTypeMismatch:    http.StatusConflict,
+
+	// 以json编码读取的值不是合法的JSON
+	CodeStringInvalidJSON: http.StatusConflict,
+	CodeHashInvalidJSON:   http.StatusConflict,
+
+	// 请求的数据不存在
+	CodeStringKeyNotFound:  http.StatusNotFound,
+	CodeHashKeyNotFound:    http.StatusNotFound,
+	CodeHashFieldNotFound:  http.StatusNotFound,
+	CodeSetMemberNotFound:  http.StatusNotFound,
+	CodeZSetMemberNotFound: http.StatusNotFound,
+	CodeZSetRankNotFound:   http.StatusNotFound,
+	CodeScriptNotFound:     http.StatusNotFound,
+
+	// 请求本身无法执行
+	CodeListIndexOutOfRange:       http.StatusBadRequest,
+	CodeScriptEvalDisabled:        http.StatusForbidden,
+	CodeScriptDisabled:            http.StatusForbidden,
+	CodeClusterCrossSlot:          http.StatusBadRequest,
+	CodePipelineCommandNotAllowed: http.StatusBadRequest,
+}
+
+// retryableCodes 稍后重试同一请求可能成功的错误码。
+// 写命令超时后可能已经执行，重试前需要确认命令是幂等的。
+var retryableCodes = map[int]bool{
+	CodeRateLimited:           true,
+	CodeRedisConnectFailed:    true,
+	CodeRedisTimeout:          true,
+	CodeRedisTargetOverloaded: true,
+	CodeRedisReadOnly:         true,
+	CodeRedisBusy:             true,
+	CodeRedisLoading:          true,
+	CodeRedisClusterRedirect:  true,
+	CodeRedisClusterDown:      true,
+}
+
+// HTTPStatus 返回错误码对应的HTTP状态码
+func HTTPStatus(code int) int {
+	if status, ok := httpStatuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// IsRetryable 返回错误码是否可重试
+func IsRetryable(code int) bool {
+	return retryableCodes[code]
+}
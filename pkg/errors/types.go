@@ -1,20 +1,47 @@
 package errors
 
-// BusinessError 定义业务错误接口
+import "fmt"
+
+// BusinessError 定义业务错误接口，是服务返回给客户端的唯一错误类型
 type BusinessError interface {
 	error
 	Code() int
 	Message() string
+	// Localize 返回指定语言的消息，locale为空时返回Message
+	Localize(locale string) string
+	// Title 返回指定语言下注册的消息模板，不代入参数，同一错误码的Title不随请求变化
+	Title(locale string) string
+	// HTTPStatus 返回错误码对应的HTTP状态码
+	HTTPStatus() int
+	// Details 返回可以展示给客户端的详情，如参数校验失败的原因
+	Details() string
+	// Retryable 表示稍后重试同一请求是否可能成功
+	Retryable() bool
+	// WithDetails 返回附带详情的副本，详情会返回给客户端，不能包含内部地址或原始错误
+	WithDetails(format string, args ...interface{}) BusinessError
+	// WithCause 返回保留底层错误的副本，底层错误只写入日志
+	WithCause(cause error) BusinessError
 }
 
 // businessError 实现BusinessError接口
 type businessError struct {
-	code    int
-	message string
+	code      int
+	status    int
+	message   string
+	details   string
+	cause     error // 底层错误，仅用于日志
+	retryable bool
+
+	// registry和args用于按其他语言重新格式化消息，自定义消息的错误没有registry
+	registry *ErrorRegistry
+	args     []interface{}
 }
 
 // Error 实现error接口
 func (e *businessError) Error() string {
+	if e.details != "" {
+		return e.message + "：" + e.details
+	}
 	return e.message
 }
 
@@ -28,10 +55,84 @@ func (e *businessError) Message() string {
 	return e.message
 }
 
-// NewBusinessError 创建业务错误实例
+// Localize 返回指定语言的消息
+func (e *businessError) Localize(locale string) string {
+	if locale == "" || e.registry == nil {
+		return e.message
+	}
+	template, ok := e.registry.Message(e.code, locale)
+	if !ok {
+		return e.message
+	}
+	if len(e.args) > 0 {
+		return fmt.Sprintf(template, e.args...)
+	}
+	return template
+}
+
+// Title 返回未格式化的消息模板，自定义消息的错误返回其消息
+func (e *businessError) Title(locale string) string {
+	if e.registry == nil {
+		return e.message
+	}
+	if locale == "" {
+		if info, ok := e.registry.Get(e.code); ok {
+			return info.Message
+		}
+		return e.message
+	}
+	template, ok := e.registry.Message(e.code, locale)
+	if !ok {
+		return e.message
+	}
+	return template
+}
+
+// HTTPStatus 返回HTTP状态码
+func (e *businessError) HTTPStatus() int {
+	return e.status
+}
+
+// Details 返回错误详情
+func (e *businessError) Details() string {
+	return e.details
+}
+
+// Retryable 返回是否可重试
+func (e *businessError) Retryable() bool {
+	return e.retryable
+}
+
+// WithDetails 返回附带详情的副本
+func (e *businessError) WithDetails(format string, args ...interface{}) BusinessError {
+	clone := *e
+	clone.details = fmt.Sprintf(format, args...)
+	return &clone
+}
+
+// WithCause 返回保留底层错误的副本
+func (e *businessError) WithCause(cause error) BusinessError {
+	clone := *e
+	clone.cause = cause
+	return &clone
+}
+
+// Unwrap 返回底层错误
+func (e *businessError) Unwrap() error {
+	return e.cause
+}
+
+// NewBusinessError 创建业务错误实例，HTTP状态码和可重试性由错误码决定
 func NewBusinessError(code int, message string) BusinessError {
+	return newBusinessError(code, message)
+}
+
+// newBusinessError 创建业务错误实例
+func newBusinessError(code int, message string) *businessError {
 	return &businessError{
-		code:    code,
-		message: message,
+		code:      code,
+		status:    HTTPStatus(code),
+		message:   message,
+		retryable: IsRetryable(code),
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,195 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// 指标类型
+const (
+	KindCounter = "counter"
+	KindGauge   = "gauge"
+)
+
+// Labels 指标标签
+type Labels map[string]string
+
+// family 同名指标的所有时间序列
+type family struct {
+	name   string
+	help   string
+	kind   string
+	mu     sync.RWMutex
+	series map[string]*series
+}
+
+// series 单个时间序列，值以float64位模式原子存储
+type series struct {
+	labels string
+	bits   uint64
+}
+
+func (s *series) add(delta float64) {
+	for {
+		old := atomic.LoadUint64(&s.bits)
+		next := math.Float64bits(math.Float64frombits(old) + delta)
+		if atomic.CompareAndSwapUint64(&s.bits, old, next) {
+			return
+		}
+	}
+}
+
+func (s *series) set(value float64) {
+	atomic.StoreUint64(&s.bits, math.Float64bits(value))
+}
+
+func (s *series) value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&s.bits))
+}
+
+// Registry 指标注册表
+type Registry struct {
+	mu       sync.RWMutex
+	families map[string]*family
+}
+
+var defaultRegistry = NewRegistry()
+
+// NewRegistry 创建指标注册表
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// Register 注册指标，重复注册同名指标时保留首次注册的定义
+func (r *Registry) Register(name, help, kind string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.families[name]; !ok {
+		r.families[name] = &family{name: name, help: help, kind: kind, series: make(map[string]*series)}
+	}
+}
+
+// Add 为计数器或仪表增加delta
+func (r *Registry) Add(name string, labels Labels, delta float64) {
+	if s := r.series(name, labels); s != nil {
+		s.add(delta)
+	}
+}
+
+// Set 设置仪表的值
+func (r *Registry) Set(name string, labels Labels, value float64) {
+	if s := r.series(name, labels); s != nil {
+		s.set(value)
+	}
+}
+
+// series 获取或创建时间序列，指标未注册时返回nil
+func (r *Registry) series(name string, labels Labels) *series {
+	r.mu.RLock()
+	f, ok := r.families[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	key := formatLabels(labels)
+	f.mu.RLock()
+	s, ok := f.series[key]
+	f.mu.RUnlock()
+	if ok {
+		return s
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if s, ok = f.series[key]; !ok {
+		s = &series{labels: key}
+		f.series[key] = s
+	}
+	return s
+}
+
+// WritePrometheus 以Prometheus文本格式输出所有指标
+func (r *Registry) WritePrometheus(w io.Writer) error {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.families))
+	for name := range r.families {
+		names = append(names, name)
+	}
+	r.mu.RUnlock()
+	sort.Strings(names)
+
+	for _, name := range names {
+		r.mu.RLock()
+		f := r.families[name]
+		r.mu.RUnlock()
+
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind); err != nil {
+			return err
+		}
+
+		f.mu.RLock()
+		keys := make([]string, 0, len(f.series))
+		for key := range f.series {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if _, err := fmt.Fprintf(w, "%s%s %v\n", f.name, key, f.series[key].value()); err != nil {
+				f.mu.RUnlock()
+				return err
+			}
+		}
+		f.mu.RUnlock()
+	}
+	return nil
+}
+
+// formatLabels 按标签名排序生成{a="1",b="2"}形式的标签串
+func formatLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(labels[name])
+		pairs[i] = fmt.Sprintf(`%s="%s"`, name, value)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Register 在默认注册表中注册指标
+func Register(name, help, kind string) {
+	defaultRegistry.Register(name, help, kind)
+}
+
+// Inc 默认注册表中的计数器加1
+func Inc(name string, labels Labels) {
+	defaultRegistry.Add(name, labels, 1)
+}
+
+// Add 默认注册表中的指标增加delta
+func Add(name string, labels Labels, delta float64) {
+	defaultRegistry.Add(name, labels, delta)
+}
+
+// Set 设置默认注册表中仪表的值
+func Set(name string, labels Labels, value float64) {
+	defaultRegistry.Set(name, labels, value)
+}
+
+// WritePrometheus 以Prometheus文本格式输出默认注册表
+func WritePrometheus(w io.Writer) error {
+	return defaultRegistry.WritePrometheus(w)
+}
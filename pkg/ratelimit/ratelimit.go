@@ -0,0 +1,158 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+// Result 一次取令牌的结果
+type Result struct {
+	Allowed    bool
+	Remaining  float64       // 取令牌后桶中剩余的令牌数
+	RetryAfter time.Duration // 被限流时距下一个令牌的时间
+	Reset      time.Duration // 桶重新装满所需的时间
+}
+
+// Store 令牌桶存储，rate为每秒补充的令牌数，burst为桶容量
+type Store interface {
+	Take(ctx context.Context, key string, rate float64, burst int) (Result, error)
+}
+
+// newResult 根据取令牌后的剩余令牌数计算结果
+func newResult(allowed bool, tokens, rate float64, burst int) Result {
+	result := Result{
+		Allowed:   allowed,
+		Remaining: tokens,
+		Reset:     seconds((float64(burst) - tokens) / rate),
+	}
+	if !allowed {
+		result.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	return result
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Max(s, 0) * float64(time.Second))
+}
+
+// memorySweepInterval 内存存储清理已装满令牌桶的间隔
+const memorySweepInterval = time.Minute
+
+// bucket 内存中的令牌桶
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time // 按当前速率装满的时间，之后可以丢弃
+}
+
+// MemoryStore 进程内令牌桶，只对当前实例生效
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore 创建进程内令牌桶存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Take 从key对应的令牌桶取一个令牌
+func (s *MemoryStore) Take(_ context.Context, key string, rate float64, burst int) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(burst), last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	result := newResult(allowed, b.tokens, rate, burst)
+	b.full = now.Add(result.Reset)
+	return result, nil
+}
+
+// sweep 丢弃已经装满的令牌桶，丢弃后再次访问会以满桶重建，结果相同
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// takeScript 原子地补充并取出令牌，使用Redis服务器时间，避免多个代理实例之间的时钟偏差
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(now - ts, 0) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore 保存在Redis中的令牌桶，多个代理实例共享限流状态
+type RedisStore struct {
+	client redis.Scripter
+	prefix string
+}
+
+// NewRedisStore 创建Redis令牌桶存储，键为prefix加限流键
+func NewRedisStore(client redis.Scripter, prefix string) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Take 从key对应的令牌桶取一个令牌
+func (s *RedisStore) Take(ctx context.Context, key string, rate float64, burst int) (Result, error) {
+	reply, err := takeScript.Run(ctx, s.client, []string{s.prefix + key},
+		strconv.FormatFloat(rate, 'f', -1, 64), burst).Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	allowed, _ := reply[0].(int64)
+	tokensText, _ := reply[1].(string)
+	tokens, err := strconv.ParseFloat(tokensText, 64)
+	if err != nil {
+		return Result{}, err
+	}
+	return newResult(allowed == 1, tokens, rate, burst), nil
+}
@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+// fakeClock 可手动推进的时钟
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestStore() (*MemoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 7, 28, 10, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	store.now = clock.Now
+	return store, clock
+}
+
+func durationNear(got, want time.Duration) bool {
+	diff := got - want
+	return diff > -time.Microsecond && diff < time.Microsecond
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	const (
+		rate  = 2.0
+		burst = 3
+	)
+	steps := []struct {
+		name       string
+		advance    time.Duration
+		allowed    bool
+		remaining  float64
+		retryAfter time.Duration
+		reset      time.Duration
+	}{
+		{"new bucket starts full", 0, true, 2, 0, 500 * time.Millisecond},
+		{"burst", 0, true, 1, 0, time.Second},
+		{"last token of the burst", 0, true, 0, 0, 1500 * time.Millisecond},
+		{"empty bucket", 0, false, 0, 500 * time.Millisecond, 1500 * time.Millisecond},
+		{"half a token refilled", 250 * time.Millisecond, false, 0.5, 250 * time.Millisecond, 1250 * time.Millisecond},
+		{"one token refilled", 250 * time.Millisecond, true, 0, 0, 1500 * time.Millisecond},
+		{"refill is capped at burst", 10 * time.Second, true, 2, 0, 500 * time.Millisecond},
+	}
+
+	store, clock := newTestStore()
+	for _, step := range steps {
+		clock.now = clock.now.Add(step.advance)
+		result, err := store.Take(context.Background(), "key", rate, burst)
+		if err != nil {
+			t.Fatalf("%s: Take() error = %v", step.name, err)
+		}
+		if result.Allowed != step.allowed {
+			t.Errorf("%s: Allowed = %v, want %v", step.name, result.Allowed, step.allowed)
+		}
+		if math.Abs(result.Remaining-step.remaining) > 1e-9 {
+			t.Errorf("%s: Remaining = %v, want %v", step.name, result.Remaining, step.remaining)
+		}
+		if !durationNear(result.RetryAfter, step.retryAfter) {
+			t.Errorf("%s: RetryAfter = %s, want %s", step.name, result.RetryAfter, step.retryAfter)
+		}
+		if !durationNear(result.Reset, step.reset) {
+			t.Errorf("%s: Reset = %s, want %s", step.name, result.Reset, step.reset)
+		}
+	}
+}
+
+func TestMemoryStoreKeys(t *testing.T) {
+	store, _ := newTestStore()
+	ctx := context.Background()
+
+	if result, _ := store.Take(ctx, "a", 1, 1); !result.Allowed {
+		t.Fatalf("first Take(a) was limited")
+	}
+	if result, _ := store.Take(ctx, "a", 1, 1); result.Allowed {
+		t.Fatalf("second Take(a) was allowed with burst 1")
+	}
+	if result, _ := store.Take(ctx, "b", 1, 1); !result.Allowed {
+		t.Fatalf("Take(b) was limited by the bucket of a")
+	}
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	store, clock := newTestStore()
+	ctx := context.Background()
+
+	// fast装满只需0.5秒，slow需要1000秒
+	store.Take(ctx, "fast", 2, 1)
+	store.Take(ctx, "slow", 0.001, 1)
+
+	clock.now = clock.now.Add(memorySweepInterval / 2)
+	store.Take(ctx, "other", 1, 1)
+	if len(store.buckets) != 3 {
+		t.Fatalf("buckets swept before the sweep interval: %d left, want 3", len(store.buckets))
+	}
+
+	clock.now = clock.now.Add(memorySweepInterval)
+	store.Take(ctx, "other", 1, 1)
+	if _, ok := store.buckets["fast"]; ok {
+		t.Errorf("full bucket fast was not swept")
+	}
+	if _, ok := store.buckets["slow"]; !ok {
+		t.Errorf("refilling bucket slow was swept")
+	}
+
+	// 丢弃的令牌桶以满桶重建
+	if result, _ := store.Take(ctx, "fast", 2, 1); !result.Allowed || result.Remaining != 0 {
+		t.Errorf("Take(fast) after sweep = %+v, want allowed with a full bucket", result)
+	}
+}
+
+func TestNewResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		allowed    bool
+		tokens     float64
+		rate       float64
+		burst      int
+		retryAfter time.Duration
+		reset      time.Duration
+	}{
+		{"allowed", true, 4, 1, 5, 0, time.Second},
+		{"full", true, 5, 1, 5, 0, 0},
+		{"limited", false, 0.25, 0.5, 2, 1500 * time.Millisecond, 3500 * time.Millisecond},
+		{"limited without tokens", false, 0, 10, 10, 100 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := newResult(tt.allowed, tt.tokens, tt.rate, tt.burst)
+			if result.Allowed != tt.allowed || result.Remaining != tt.tokens {
+				t.Errorf("result = %+v, want Allowed %v Remaining %v", result, tt.allowed, tt.tokens)
+			}
+			if !durationNear(result.RetryAfter, tt.retryAfter) {
+				t.Errorf("RetryAfter = %s, want %s", result.RetryAfter, tt.retryAfter)
+			}
+			if !durationNear(result.Reset, tt.reset) {
+				t.Errorf("Reset = %s, want %s", result.Reset, tt.reset)
+			}
+		})
+	}
+}
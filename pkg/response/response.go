@@ -1,6 +1,8 @@
 package response
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,10 +12,46 @@ import (
 
 // BaseResponse represents the standard API response structure
 type BaseResponse struct {
-	Code    int         `json:"code"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data,omitempty"`
-	Error   string      `json:"error,omitempty"`
+	Code      int         `json:"code"`
+	Message   string      `json:"message"`
+	Data      interface{} `json:"data,omitempty"`
+	Details   string      `json:"details,omitempty"`
+	Retryable bool        `json:"retryable,omitempty"`
+}
+
+// Problem is an RFC 7807 problem details document, sent instead of BaseResponse
+// when the request negotiated application/problem+json
+type Problem struct {
+	Type     string `json:"type" example:"urn:go-redis-proxy:error:2101"`
+	Title    string `json:"title" example:"类型不匹配"`
+	Status   int    `json:"status" example:"409"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty" example:"20250728100000-a1b2c3d4"` // request ID
+
+	// Extension members
+	Code      int    `json:"code" example:"2101"`
+	Module    string `json:"module,omitempty" example:"string"`
+	Retryable bool   `json:"retryable"`
+}
+
+// ProblemContentType is the media type of problem details documents
+const ProblemContentType = "application/problem+json"
+
+// problemTypeKey holds the type URI prefix of a request that negotiated problem details
+const problemTypeKey = "problem_type_base"
+
+// UseProblemDetails makes the error responses of a request problem details documents
+// whose type URI is typeBase followed by the error code
+func UseProblemDetails(c *gin.Context, typeBase string) {
+	c.Set(problemTypeKey, typeBase)
+}
+
+// localeKey holds the language negotiated for the error messages of a request
+const localeKey = "locale"
+
+// SetLocale sets the language of the error messages of a request
+func SetLocale(c *gin.Context, locale string) {
+	c.Set(localeKey, locale)
 }
 
 // Success sends a successful response
@@ -34,140 +72,94 @@ func SuccessWithMessage(c *gin.Context, message string, data interface{}) {
 	})
 }
 
-// Error sends an error response
-func Error(c *gin.Context, httpCode int, message string, err error) {
-	response := BaseResponse{
-		Code:    httpCode,
-		Message: message,
-	}
-
-	if err != nil {
-		response.Error = err.Error()
-	}
-
-	c.JSON(httpCode, response)
-}
-
-// BadRequest sends a 400 bad request response
-func BadRequest(c *gin.Context, message string, err error) {
-	Error(c, http.StatusBadRequest, message, err)
-}
-
-// Unauthorized sends a 401 unauthorized response
-func Unauthorized(c *gin.Context, message string, err error) {
-	Error(c, http.StatusUnauthorized, message, err)
-}
-
-// Forbidden sends a 403 forbidden response
-func Forbidden(c *gin.Context, message string, err error) {
-	Error(c, http.StatusForbidden, message, err)
+// Fail sends a business error with the HTTP status of its code.
+// The underlying cause is recorded for the request log and never sent to the client.
+func Fail(c *gin.Context, err apperrors.BusinessError) {
+	recordCause(c, err)
+	c.JSON(err.HTTPStatus(), errorResponse(c, err))
 }
 
-// NotFound sends a 404 not found response
-func NotFound(c *gin.Context, message string, err error) {
-	Error(c, http.StatusNotFound, message, err)
+// Abort sends a business error like Fail and stops the remaining handlers,
+// used by middleware that rejects a request before it reaches a handler
+func Abort(c *gin.Context, err apperrors.BusinessError) {
+	recordCause(c, err)
+	c.AbortWithStatusJSON(err.HTTPStatus(), errorResponse(c, err))
 }
 
-// InternalServerError sends a 500 internal server error response
-func InternalServerError(c *gin.Context, message string, err error) {
-	Error(c, http.StatusInternalServerError, message, err)
-}
-
-// HandleAppError handles application errors and sends appropriate HTTP responses
-func HandleAppError(c *gin.Context, err error) {
-	if appErr, ok := apperrors.IsAppError(err); ok {
-		switch appErr.Code {
-		case apperrors.ErrCodeInvalidRequest,
-			 apperrors.ErrCodeMissingParameter,
-			 apperrors.ErrCodeInvalidParameter:
-			BadRequest(c, appErr.Message, appErr)
-		case apperrors.ErrCodeKeyNotFound:
-			NotFound(c, appErr.Message, appErr)
-		case apperrors.ErrCodeRedisConnection,
-			 apperrors.ErrCodeRedisOperation,
-			 apperrors.ErrCodeDatabase,
-			 apperrors.ErrCodeInternal:
-			InternalServerError(c, appErr.Message, appErr)
-		default:
-			InternalServerError(c, "Unknown error", appErr)
-		}
-		return
+// BadRequest sends CodeInvalidParams with the validation message, and the binding error if any, as details
+func BadRequest(c *gin.Context, message string, err error) {
+	details := message
+	if err != nil {
+		details = message + ": " + err.Error()
 	}
-
-	// Handle non-application errors
-	InternalServerError(c, "Internal server error", err)
+	Fail(c, apperrors.NewError(apperrors.CodeInvalidParams).WithDetails("%s", details))
 }
 
-// JSON handles business data and errors, formats them into standard response
+// JSON sends the data of a service call, or its error. Errors that are not business errors
+// are reported as CodeInternalError so that their text does not reach the client.
 func JSON(c *gin.Context, data interface{}, err error) {
-	if err != nil {
-		// Try to convert to BusinessError
-		if bizErr, ok := err.(interface {
-			Code() int
-			Message() string
-		}); ok {
-			c.JSON(http.StatusOK, BaseResponse{
-				Code:    bizErr.Code(),
-				Message: bizErr.Message(),
-				Data:    data,
-			})
-			return
-		}
-		
-		// Handle standard error
-		c.JSON(http.StatusOK, BaseResponse{
-			Code:    500,
-			Message: err.Error(),
-			Data:    data,
-		})
+	if err == nil {
+		Success(c, data)
 		return
 	}
-	
-	// Success response
-	c.JSON(http.StatusOK, BaseResponse{
-		Code:    200,
-		Message: "Success",
-		Data:    data,
-	})
-}
 
-// ValidateRequest validates required fields and sends error response if validation fails
-func ValidateRequest(c *gin.Context, conditions ...func() (bool, string)) bool {
-	for _, condition := range conditions {
-		if valid, message := condition(); !valid {
-			BadRequest(c, "Validation failed", apperrors.NewInvalidRequestError(message, nil))
-			return false
-		}
+	var bizErr apperrors.BusinessError
+	if !errors.As(err, &bizErr) {
+		bizErr = apperrors.Wrap(err, apperrors.CodeInternalError)
 	}
-	return true
+	Fail(c, bizErr)
 }
 
-// RequiredField creates a validation condition for required fields
-func RequiredField(value string, fieldName string) func() (bool, string) {
-	return func() (bool, string) {
-		if value == "" {
-			return false, fieldName + " is required"
+// errorResponse renders a business error in the format the request negotiated
+func errorResponse(c *gin.Context, err apperrors.BusinessError) interface{} {
+	locale := c.GetString(localeKey)
+	if locale != "" {
+		c.Header("Content-Language", locale)
+	}
+	message := err.Localize(locale)
+
+	typeBase, ok := c.Get(problemTypeKey)
+	if !ok {
+		return BaseResponse{
+			Code:      err.Code(),
+			Message:   message,
+			Details:   err.Details(),
+			Retryable: err.Retryable(),
 		}
-		return true, ""
 	}
-}
 
-// RequiredSlice creates a validation condition for required slices
-func RequiredSlice(value []string, fieldName string) func() (bool, string) {
-	return func() (bool, string) {
-		if len(value) == 0 {
-			return false, fieldName + " is required and cannot be empty"
+	// The title stays the same for every occurrence of a code, the text with the
+	// arguments filled in goes to the detail together with the error details
+	title := err.Title(locale)
+	detail := err.Details()
+	if message != title {
+		if detail != "" {
+			detail = message + ": " + detail
+		} else {
+			detail = message
 		}
-		return true, ""
 	}
+
+	// gin keeps a Content-Type that is already set when rendering JSON
+	c.Header("Content-Type", ProblemContentType)
+	problem := Problem{
+		Type:      fmt.Sprintf("%v%d", typeBase, err.Code()),
+		Title:     title,
+		Status:    err.HTTPStatus(),
+		Detail:    detail,
+		Instance:  c.GetString("request_id"),
+		Code:      err.Code(),
+		Retryable: err.Retryable(),
+	}
+	if info, ok := apperrors.Lookup(err.Code()); ok {
+		problem.Module = info.Module
+	}
+	return problem
 }
 
-// PositiveInt creates a validation condition for positive integers
-func PositiveInt(value int64, fieldName string) func() (bool, string) {
-	return func() (bool, string) {
-		if value <= 0 {
-			return false, fieldName + " must be greater than 0"
-		}
-		return true, ""
+// recordCause keeps the underlying error of a business error for the request log
+func recordCause(c *gin.Context, err apperrors.BusinessError) {
+	if cause := errors.Unwrap(err); cause != nil {
+		_ = c.Error(cause)
 	}
-}
\ No newline at end of file
+}
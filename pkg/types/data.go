@@ -7,6 +7,11 @@ type StringGetData struct {
 	Value interface{} `json:"value"`
 }
 
+// StringMGetData String MGET操作的业务数据
+type StringMGetData struct {
+	Values []interface{} `json:"values"` // 与请求中的keys一一对应，键不存在时为null
+}
+
 // StringSetData String SET操作的业务数据
 type StringSetData struct {
 	Result string `json:"result"`
@@ -84,6 +89,33 @@ type ListLTrimData struct {
 	Result string `json:"result"`
 }
 
+// Set操作的业务数据类型
+
+// SetCountData Set SADD、SREM、SCARD、SINTERCARD和*STORE操作的业务数据
+type SetCountData struct {
+	Count int64 `json:"count"` // 添加、删除、统计或写入目标集合的成员数量
+}
+
+// SetMembersData Set SMEMBERS、SINTER、SUNION、SDIFF、SPOP和SRANDMEMBER操作的业务数据
+type SetMembersData struct {
+	Members []string `json:"members"`
+}
+
+// SetIsMemberData Set SISMEMBER操作的业务数据
+type SetIsMemberData struct {
+	Exists bool `json:"exists"`
+}
+
+// SetMIsMemberData Set SMISMEMBER操作的业务数据
+type SetMIsMemberData struct {
+	Exists []bool `json:"exists"` // 与请求中的成员一一对应
+}
+
+// SetMoveData Set SMOVE操作的业务数据
+type SetMoveData struct {
+	Moved bool `json:"moved"`
+}
+
 // ZSet操作的业务数据类型
 
 // ZSetMember 表示ZSet成员及其分数
@@ -94,7 +126,8 @@ type ZSetMember struct {
 
 // ZSetZAddData ZSet ZADD操作的业务数据
 type ZSetZAddData struct {
-	Added int64 `json:"added"` // 新添加的成员数量
+	Added int64       `json:"added"`           // 新添加的成员数量，ch时为被修改的成员数量
+	Score interface{} `json:"score,omitempty"` // incr时的新分数，操作因nx/xx/gt/lt被放弃时为nil
 }
 
 // ZSetZIncrByData ZSet ZINCRBY操作的业务数据
@@ -162,8 +195,75 @@ type ZSetZRemRangeByScoreData struct {
 	Removed int64 `json:"removed"` // 被移除的成员数量
 }
 
+// ZSetZRangeByLexData ZSet ZRANGEBYLEX操作的业务数据
+type ZSetZRangeByLexData struct {
+	Members []string `json:"members"` // 成员列表
+}
+
+// ZSetZLexCountData ZSet ZLEXCOUNT操作的业务数据
+type ZSetZLexCountData struct {
+	Count int64 `json:"count"` // 指定字典序范围内的元素数量
+}
+
+// ZSetZRemRangeByLexData ZSet ZREMRANGEBYLEX操作的业务数据
+type ZSetZRemRangeByLexData struct {
+	Removed int64 `json:"removed"` // 被移除的成员数量
+}
+
+// ZSetZPopMinData ZSet ZPOPMIN操作的业务数据
+type ZSetZPopMinData struct {
+	Members []ZSetMember `json:"members"` // 被弹出的成员及分数
+}
+
+// ZSetZPopMaxData ZSet ZPOPMAX操作的业务数据
+type ZSetZPopMaxData struct {
+	Members []ZSetMember `json:"members"` // 被弹出的成员及分数
+}
+
+// ZSetBZPopMinData ZSet BZPOPMIN操作的业务数据
+type ZSetBZPopMinData struct {
+	Key    string      `json:"key,omitempty"` // 弹出成员所在的key
+	Member *ZSetMember `json:"member"`        // 被弹出的成员，超时则为nil
+}
+
+// ZSetZMScoreData ZSet ZMSCORE操作的业务数据
+type ZSetZMScoreData struct {
+	Scores []interface{} `json:"scores"` // 与请求成员顺序一致，不存在的成员为nil
+}
+
+// ZSetZRandMemberData ZSet ZRANDMEMBER操作的业务数据
+type ZSetZRandMemberData struct {
+	Members interface{} `json:"members"` // with_scores时为[{member, score}]，否则为成员名列表
+}
+
+// ZSetZUnionData ZSet ZUNION操作的业务数据
+type ZSetZUnionData struct {
+	Members interface{} `json:"members"` // with_scores时为[{member, score}]，否则为成员名列表
+}
+
+// ZSetZInterData ZSet ZINTER操作的业务数据
+type ZSetZInterData struct {
+	Members interface{} `json:"members"` // with_scores时为[{member, score}]，否则为成员名列表
+}
+
+// ZSetZDiffData ZSet ZDIFF操作的业务数据
+type ZSetZDiffData struct {
+	Members interface{} `json:"members"` // with_scores时为[{member, score}]，否则为成员名列表
+}
+
+// ZSetStoreData ZSet ZUNIONSTORE/ZINTERSTORE/ZDIFFSTORE操作的业务数据
+type ZSetStoreData struct {
+	Count int64 `json:"count"` // 目标有序集合中的成员数量
+}
+
 // Hash操作的业务数据类型
 
+// HashField 表示Hash字段及其值
+type HashField struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
 // HashHSetData Hash HSET操作的业务数据
 type HashHSetData struct {
 	Set int64 `json:"set"` // 被设置的字段数量
@@ -181,7 +281,7 @@ type HashHMGetData struct {
 
 // HashHGetAllData Hash HGETALL操作的业务数据
 type HashHGetAllData struct {
-	Fields map[string]string `json:"fields"` // 所有字段和值的映射
+	Fields map[string]interface{} `json:"fields"` // 所有字段和值的映射，encoding为json时值为解析后的JSON
 }
 
 // HashHDelData Hash HDEL操作的业务数据
@@ -212,4 +312,300 @@ type HashHValsData struct {
 // HashHIncrByData Hash HINCRBY操作的业务数据
 type HashHIncrByData struct {
 	Value int64 `json:"value"` // 增量操作后的值
-}
\ No newline at end of file
+}
+
+// HashHSetNXData Hash HSETNX操作的业务数据
+type HashHSetNXData struct {
+	Set bool `json:"set"` // 字段是否被设置，字段已存在时为false
+}
+
+// HashHIncrByFloatData Hash HINCRBYFLOAT操作的业务数据
+type HashHIncrByFloatData struct {
+	Value float64 `json:"value"` // 增量操作后的值
+}
+
+// HashHStrLenData Hash HSTRLEN操作的业务数据
+type HashHStrLenData struct {
+	Length int64 `json:"length"` // 字段值的字符串长度，字段不存在时为0
+}
+
+// HashHRandFieldData Hash HRANDFIELD操作的业务数据
+type HashHRandFieldData struct {
+	Fields interface{} `json:"fields"` // with_values时为[{field, value}]，否则为字段名列表
+}
+
+// HashHExpireData Hash HEXPIRE操作的业务数据
+type HashHExpireData struct {
+	Results []int64 `json:"results"` // 每个字段的结果：-2字段不存在，0条件不满足，1已设置，2已删除
+}
+
+// HashHTTLData Hash HTTL操作的业务数据
+type HashHTTLData struct {
+	TTLs []int64 `json:"ttls"` // 每个字段的剩余秒数：-2字段不存在，-1未设置过期时间
+}
+
+// HashHPersistData Hash HPERSIST操作的业务数据
+type HashHPersistData struct {
+	Results []int64 `json:"results"` // 每个字段的结果：-2字段不存在，-1未设置过期时间，1已移除过期时间
+}
+
+// BitmapSetBitData Bitmap SETBIT操作的业务数据
+type BitmapSetBitData struct {
+	Previous int64 `json:"previous"` // 设置前的位值
+}
+
+// BitmapGetBitData Bitmap GETBIT操作的业务数据
+type BitmapGetBitData struct {
+	Value int64 `json:"value"` // 位值
+}
+
+// BitmapBitCountData Bitmap BITCOUNT操作的业务数据
+type BitmapBitCountData struct {
+	Count int64 `json:"count"` // 值为1的位数
+}
+
+// BitmapBitPosData Bitmap BITPOS操作的业务数据
+type BitmapBitPosData struct {
+	Position int64 `json:"position"` // 第一个匹配位的位置，未找到时为-1
+}
+
+// BitmapBitOpData Bitmap BITOP操作的业务数据
+type BitmapBitOpData struct {
+	Length int64 `json:"length"` // 结果键的字节长度
+}
+
+// BitFieldResult BITFIELD单个子操作的结果
+type BitFieldResult struct {
+	Op     string `json:"op"`     // 子操作：GET、SET、INCRBY
+	Type   string `json:"type"`   // 整数类型
+	Offset string `json:"offset"` // 位偏移
+	Value  *int64 `json:"value"`  // GET为当前值，SET为旧值，INCRBY为新值；OVERFLOW FAIL生效时为null
+}
+
+// BitmapBitFieldData Bitmap BITFIELD/BITFIELD_RO操作的业务数据
+type BitmapBitFieldData struct {
+	Results []BitFieldResult `json:"results"` // 与非OVERFLOW子操作一一对应
+}
+
+// HLLPFAddData HyperLogLog PFADD操作的业务数据
+type HLLPFAddData struct {
+	Updated bool `json:"updated"` // 内部寄存器是否被修改
+}
+
+// HLLPFCountData HyperLogLog PFCOUNT操作的业务数据
+type HLLPFCountData struct {
+	Count int64 `json:"count"` // 近似基数
+}
+
+// HLLPFMergeData HyperLogLog PFMERGE操作的业务数据
+type HLLPFMergeData struct {
+	Result string `json:"result"`
+}
+
+// GeoPosition 经纬度坐标
+type GeoPosition struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+}
+
+// GeoMemberPosition 成员及其坐标
+type GeoMemberPosition struct {
+	Member   string       `json:"member"`
+	Position *GeoPosition `json:"position"` // 成员不存在时为null
+}
+
+// GeoMemberHash 成员及其geohash
+type GeoMemberHash struct {
+	Member string  `json:"member"`
+	Hash   *string `json:"hash"` // 成员不存在时为null
+}
+
+// GeoSearchLocation GEOSEARCH返回的单个成员
+type GeoSearchLocation struct {
+	Member   string       `json:"member"`
+	Distance *float64     `json:"distance,omitempty"` // with_dist时返回，单位与请求一致
+	Position *GeoPosition `json:"position,omitempty"` // with_coord时返回
+}
+
+// GeoAddData Geo GEOADD操作的业务数据
+type GeoAddData struct {
+	Count int64 `json:"count"` // 新增的成员数，指定ch时包含位置被更新的成员
+}
+
+// GeoPosData Geo GEOPOS操作的业务数据
+type GeoPosData struct {
+	Positions []GeoMemberPosition `json:"positions"`
+}
+
+// GeoDistData Geo GEODIST操作的业务数据
+type GeoDistData struct {
+	Distance *float64 `json:"distance"` // 任一成员不存在时为null
+	Unit     string   `json:"unit"`
+}
+
+// GeoHashData Geo GEOHASH操作的业务数据
+type GeoHashData struct {
+	Hashes []GeoMemberHash `json:"hashes"`
+}
+
+// GeoSearchData Geo GEOSEARCH操作的业务数据
+type GeoSearchData struct {
+	Locations []GeoSearchLocation `json:"locations"`
+}
+
+// GeoSearchStoreData Geo GEOSEARCHSTORE操作的业务数据
+type GeoSearchStoreData struct {
+	Count int64 `json:"count"` // 结果键中的成员数
+}
+
+// ScriptEvalData Script EVAL/EVALSHA及命名脚本执行的业务数据
+type ScriptEvalData struct {
+	Result interface{} `json:"result"` // 脚本返回值，Lua表转换为数组，nil转换为null
+}
+
+// ScriptLoadData Script SCRIPT LOAD操作的业务数据
+type ScriptLoadData struct {
+	SHA string `json:"sha"` // 脚本的SHA1摘要
+}
+
+// ScriptExistsData Script SCRIPT EXISTS操作的业务数据
+type ScriptExistsData struct {
+	Exists []bool `json:"exists"` // 与请求的SHA一一对应
+}
+
+// ScriptFlushData Script SCRIPT FLUSH操作的业务数据
+type ScriptFlushData struct {
+	Result string `json:"result"`
+}
+
+// ScriptInfo 已注册的命名脚本
+type ScriptInfo struct {
+	Name    string `json:"name"`
+	SHA     string `json:"sha"`
+	Enabled bool   `json:"enabled"`
+	Origin  string `json:"origin"` // 脚本来源：config或文件路径
+}
+
+// ScriptListData 命名脚本列表的业务数据
+type ScriptListData struct {
+	Scripts     []ScriptInfo `json:"scripts"`
+	EvalEnabled bool         `json:"eval_enabled"` // 是否允许临时脚本
+}
+
+// FunctionInfo 函数库中的单个函数
+type FunctionInfo struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Flags       []string `json:"flags"`
+}
+
+// FunctionLibrary 函数库信息
+type FunctionLibrary struct {
+	LibraryName string         `json:"library_name"`
+	Engine      string         `json:"engine"`
+	Functions   []FunctionInfo `json:"functions"`
+	LibraryCode string         `json:"library_code,omitempty"` // with_code时返回
+}
+
+// FunctionLoadData Function FUNCTION LOAD操作的业务数据
+type FunctionLoadData struct {
+	LibraryName string `json:"library_name"`
+}
+
+// FunctionListData Function FUNCTION LIST操作的业务数据
+type FunctionListData struct {
+	Libraries []FunctionLibrary `json:"libraries"`
+}
+
+// FunctionDeleteData Function FUNCTION DELETE操作的业务数据
+type FunctionDeleteData struct {
+	Result string `json:"result"`
+}
+
+// FunctionDumpData Function FUNCTION DUMP操作的业务数据
+type FunctionDumpData struct {
+	Payload string `json:"payload"` // base64编码的序列化数据
+}
+
+// FunctionRestoreData Function FUNCTION RESTORE操作的业务数据
+type FunctionRestoreData struct {
+	Result string `json:"result"`
+}
+
+// FunctionCallData Function FCALL/FCALL_RO操作的业务数据
+type FunctionCallData struct {
+	Result  interface{} `json:"result"`  // 函数返回值
+	Replica bool        `json:"replica"` // 是否由只读副本执行
+}
+
+// Pipeline操作的业务数据类型
+
+// PipelineResult 单个命令的执行结果
+type PipelineResult struct {
+	Result interface{} `json:"result"`          // 命令返回值
+	Error  string      `json:"error,omitempty"` // Redis返回的命令错误
+}
+
+// PipelineData Pipeline操作的业务数据
+type PipelineData struct {
+	Results []PipelineResult `json:"results"` // 与请求中的commands一一对应
+}
+
+// Health相关的业务数据类型
+
+// ClusterSlotRange 集群哈希槽区间及其节点
+type ClusterSlotRange struct {
+	Start    int      `json:"start"`
+	End      int      `json:"end"`
+	Master   string   `json:"master"`
+	Replicas []string `json:"replicas"`
+}
+
+// TargetHealth 命名目标的健康状态
+type TargetHealth struct {
+	Name     string             `json:"name"`
+	Type     string             `json:"type"`
+	Status   string             `json:"status"` // up或down
+	Error    string             `json:"error,omitempty"`
+	Topology []ClusterSlotRange `json:"topology,omitempty"` // 集群目标的槽分布
+	Replicas []ReplicaHealth    `json:"replicas,omitempty"` // standalone目标的副本状态
+	Shards   []ShardHealth      `json:"shards,omitempty"`   // sharded目标的节点状态
+}
+
+// ShardHealth sharded目标节点的状态
+type ShardHealth struct {
+	Name   string `json:"name"`
+	Addr   string `json:"addr"`
+	Status string `json:"status"` // up或down
+	Error  string `json:"error,omitempty"`
+}
+
+// ReplicaHealth standalone目标副本的读路由状态
+type ReplicaHealth struct {
+	Addr       string  `json:"addr"`
+	InRotation bool    `json:"in_rotation"` // 是否参与读轮询
+	LagSeconds int64   `json:"lag_seconds"` // 主节点报告的复制延迟，-1表示未知
+	LatencyMS  float64 `json:"latency_ms"`  // 最近一次PING耗时
+}
+
+// HealthData 健康检查的业务数据
+type HealthData struct {
+	Status  string         `json:"status"` // ok，或存在不可用目标时为degraded
+	Targets []TargetHealth `json:"targets"`
+}
+
+// BreakerStatus Redis目标熔断器的状态
+type BreakerStatus struct {
+	Target       string `json:"target"`                   // 命名目标名称，直连地址为addr:host:port
+	State        string `json:"state"`                    // closed、open或half-open
+	Since        string `json:"since"`                    // 进入当前状态的时间
+	Requests     int    `json:"requests"`                 // closed状态统计窗口内的命令数
+	Failures     int    `json:"failures"`                 // 其中失败的命令数
+	Slow         int    `json:"slow"`                     // 其中超过慢命令阈值的命令数
+	RetryAfterMS int64  `json:"retry_after_ms,omitempty"` // open状态剩余的熔断时间
+}
+
+// BreakerData 熔断器状态的业务数据
+type BreakerData struct {
+	Breakers []BreakerStatus `json:"breakers"`
+}
@@ -1,24 +1,100 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // RedisRequest 包含连接Redis所需的基础参数
 type RedisRequest struct {
-	Addr     string `json:"addr"`
-	Password string `json:"password"`
-	DB       int    `json:"db"`
+	Addr        string `json:"addr"`
+	Username    string `json:"username,omitempty"` // Redis 6 ACL用户名，为空时使用default用户
+	Password    string `json:"password"`
+	DB          int    `json:"db"`
+	TLS         bool   `json:"tls,omitempty"`         // 使用TLS连接addr，CA和客户端证书使用服务端REDIS_TLS_*配置
+	Target      string `json:"target,omitempty"`      // 命名目标，设置后忽略addr、username、password、db和tls
+	Consistency string `json:"consistency,omitempty"` // strong时读操作也发往主节点，默认允许读副本
+	TimeoutMS   int    `json:"timeout_ms,omitempty"`  // 请求整体超时（毫秒），不超过服务端上限，为0时使用服务端默认值
+}
+
+// ConsistencyStrong 强一致读，读操作不发往副本
+const ConsistencyStrong = "strong"
+
+// ValueEncoding 值、成员和字段在请求和响应中的编码，用于传输非UTF-8的二进制数据，键不受影响
+type ValueEncoding struct {
+	Encoding         string `json:"encoding,omitempty" enums:"utf8,base64,hex,json"`          // 请求中值、成员和字段的编码，默认utf8
+	ResponseEncoding string `json:"response_encoding,omitempty" enums:"utf8,base64,hex,json"` // 响应中值、成员和字段的编码，为空时与encoding相同
+}
+
+// 值编码
+const (
+	EncodingUTF8   = "utf8"   // 原样传输，响应中无效的UTF-8字节会被替换为U+FFFD
+	EncodingBase64 = "base64" // 标准base64，带填充
+	EncodingHex    = "hex"    // 十六进制，不区分大小写
+	EncodingJSON   = "json"   // 值为JSON文档，只用于SET、HSET、GET、MGET、HGET、HMGET和HGETALL，字段名按utf8处理
+)
+
+// Value 可以是JSON文档的值：encoding为json时可以是任意JSON，否则必须是字符串
+type Value struct {
+	raw json.RawMessage
+}
+
+// UnmarshalJSON 保存原始JSON，由Service层按encoding解析
+func (v *Value) UnmarshalJSON(data []byte) error {
+	v.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON 返回原始JSON
+func (v Value) MarshalJSON() ([]byte, error) {
+	if len(v.raw) == 0 {
+		return []byte(`""`), nil
+	}
+	return v.raw, nil
+}
+
+// Raw 返回原始JSON
+func (v Value) Raw() json.RawMessage {
+	return v.raw
+}
+
+// Text 返回字符串值，值不是JSON字符串时返回错误
+func (v Value) Text() (string, error) {
+	var text string
+	if err := json.Unmarshal(v.raw, &text); err != nil {
+		return "", fmt.Errorf("value must be a string unless encoding is json")
+	}
+	return text, nil
+}
+
+// IsEmpty 值未设置或为空字符串
+func (v Value) IsEmpty() bool {
+	return len(v.raw) == 0 || string(v.raw) == `""`
 }
 
 // StringGetRequest 定义了GET string类型value的请求体
 type StringGetRequest struct {
 	RedisRequest
-	Key string `json:"key"`
+	ValueEncoding
+	Key      string `json:"key"`
+	JSONPath string `json:"json_path,omitempty"` // encoding为json时只返回文档中的子字段，如$.user.tags[0]
+}
+
+// StringMGetRequest 定义了MGET操作的请求体
+type StringMGetRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys     []string `json:"keys"`
+	JSONPath string   `json:"json_path,omitempty"` // encoding为json时只返回每个文档中的子字段
 }
 
 // StringSetRequest 定义了SET string类型value的请求体
 type StringSetRequest struct {
 	RedisRequest
-	Key    string `json:"key"`
-	Value  string `json:"value"`
-	TTL    int    `json:"ttl,omitempty"` // 过期时间，单位秒，0表示不过期
+	ValueEncoding
+	Key   string `json:"key"`
+	Value Value  `json:"value" swaggertype:"string"` // encoding为json时可以是任意JSON
+	TTL   int    `json:"ttl,omitempty"`              // 过期时间，单位秒，0表示不过期
 }
 
 // StringDelRequest 定义了DEL key的请求体
@@ -57,6 +133,7 @@ type StringExpireRequest struct {
 // ListLPushRequest 定义了LPUSH操作的请求体
 type ListLPushRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string   `json:"key"`
 	Values []string `json:"values"` // 要推入的值数组
 }
@@ -64,6 +141,7 @@ type ListLPushRequest struct {
 // ListRPushRequest 定义了RPUSH操作的请求体
 type ListRPushRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string   `json:"key"`
 	Values []string `json:"values"` // 要推入的值数组
 }
@@ -71,18 +149,21 @@ type ListRPushRequest struct {
 // ListLPopRequest 定义了LPOP操作的请求体
 type ListLPopRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key string `json:"key"`
 }
 
 // ListRPopRequest 定义了RPOP操作的请求体
 type ListRPopRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key string `json:"key"`
 }
 
 // ListLRemRequest 定义了LREM操作的请求体
 type ListLRemRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key   string `json:"key"`
 	Count int64  `json:"count"` // 删除的数量，0表示删除所有
 	Value string `json:"value"` // 要删除的值
@@ -91,6 +172,7 @@ type ListLRemRequest struct {
 // ListLIndexRequest 定义了LINDEX操作的请求体
 type ListLIndexRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key   string `json:"key"`
 	Index int64  `json:"index"` // 索引位置
 }
@@ -98,6 +180,7 @@ type ListLIndexRequest struct {
 // ListLRangeRequest 定义了LRANGE操作的请求体
 type ListLRangeRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key   string `json:"key"`
 	Start int64  `json:"start"` // 开始索引
 	Stop  int64  `json:"stop"`  // 结束索引
@@ -122,6 +205,7 @@ type ListLTrimRequest struct {
 // RedisSAddRequest 定义了SADD操作的请求体
 type RedisSAddRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key     string   `json:"key"`
 	Members []string `json:"members"`
 }
@@ -129,6 +213,7 @@ type RedisSAddRequest struct {
 // RedisSRemRequest 定义了SREM操作的请求体
 type RedisSRemRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key     string   `json:"key"`
 	Members []string `json:"members"`
 }
@@ -136,6 +221,7 @@ type RedisSRemRequest struct {
 // RedisSIsMemberRequest 定义了SISMEMBER操作的请求体
 type RedisSIsMemberRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string `json:"key"`
 	Member string `json:"member"`
 }
@@ -143,6 +229,7 @@ type RedisSIsMemberRequest struct {
 // RedisSMembersRequest 定义了SMEMBERS操作的请求体
 type RedisSMembersRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key string `json:"key"`
 }
 
@@ -152,18 +239,114 @@ type RedisSCardRequest struct {
 	Key string `json:"key"`
 }
 
+// RedisSInterRequest 定义了SINTER操作的请求体
+type RedisSInterRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys []string `json:"keys"`
+}
+
+// RedisSInterStoreRequest 定义了SINTERSTORE操作的请求体
+type RedisSInterStoreRequest struct {
+	RedisRequest
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+}
+
+// RedisSInterCardRequest 定义了SINTERCARD操作的请求体
+type RedisSInterCardRequest struct {
+	RedisRequest
+	Keys  []string `json:"keys"`
+	Limit int64    `json:"limit,omitempty"` // 计数上限，0表示不限制
+}
+
+// RedisSUnionRequest 定义了SUNION操作的请求体
+type RedisSUnionRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys []string `json:"keys"`
+}
+
+// RedisSUnionStoreRequest 定义了SUNIONSTORE操作的请求体
+type RedisSUnionStoreRequest struct {
+	RedisRequest
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+}
+
+// RedisSDiffRequest 定义了SDIFF操作的请求体
+type RedisSDiffRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys []string `json:"keys"` // 第一个key与其余key的差集
+}
+
+// RedisSDiffStoreRequest 定义了SDIFFSTORE操作的请求体
+type RedisSDiffStoreRequest struct {
+	RedisRequest
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+}
+
+// RedisSMoveRequest 定义了SMOVE操作的请求体
+type RedisSMoveRequest struct {
+	RedisRequest
+	ValueEncoding
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Member      string `json:"member"`
+}
+
+// RedisSPopRequest 定义了SPOP操作的请求体
+type RedisSPopRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key   string `json:"key"`
+	Count int64  `json:"count,omitempty"` // 弹出数量，0表示弹出单个成员
+}
+
+// RedisSRandMemberRequest 定义了SRANDMEMBER操作的请求体
+type RedisSRandMemberRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key   string `json:"key"`
+	Count int64  `json:"count,omitempty"` // 返回数量，0表示单个成员，负数表示允许重复
+}
+
+// RedisSMIsMemberRequest 定义了SMISMEMBER操作的请求体
+type RedisSMIsMemberRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key     string   `json:"key"`
+	Members []string `json:"members"`
+}
+
 // ZSet操作请求类型
 
+// ZAddFlags 定义了ZADD命令的可选标志
+type ZAddFlags struct {
+	NX   bool `json:"nx,omitempty"`   // 只添加新成员，不更新已存在成员
+	XX   bool `json:"xx,omitempty"`   // 只更新已存在成员，不添加新成员
+	GT   bool `json:"gt,omitempty"`   // 仅当新分数大于当前分数时更新
+	LT   bool `json:"lt,omitempty"`   // 仅当新分数小于当前分数时更新
+	CH   bool `json:"ch,omitempty"`   // 返回值改为被修改（新增+更新）的成员数量
+	Incr bool `json:"incr,omitempty"` // 以ZINCRBY方式对唯一成员的分数做增量
+}
+
 // ZSetZAddRequest 定义了ZADD操作的请求体
 type ZSetZAddRequest struct {
 	RedisRequest
-	Key     string            `json:"key"`
-	Members map[string]float64 `json:"members"` // member -> score 映射
+	ValueEncoding
+	ZAddFlags
+	Key           string             `json:"key"`
+	Members       map[string]float64 `json:"members,omitempty"`        // member -> score 映射，按成员名排序后发送
+	ScoredMembers []ZSetMember       `json:"scored_members,omitempty"` // 有序的成员列表，按给定顺序发送
 }
 
 // ZSetZIncrByRequest 定义了ZINCRBY操作的请求体
 type ZSetZIncrByRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key       string  `json:"key"`
 	Increment float64 `json:"increment"`
 	Member    string  `json:"member"`
@@ -172,6 +355,7 @@ type ZSetZIncrByRequest struct {
 // ZSetZScoreRequest 定义了ZSCORE操作的请求体
 type ZSetZScoreRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string `json:"key"`
 	Member string `json:"member"`
 }
@@ -193,6 +377,7 @@ type ZSetZCountRequest struct {
 // ZSetZRankRequest 定义了ZRANK操作的请求体
 type ZSetZRankRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string `json:"key"`
 	Member string `json:"member"`
 }
@@ -200,22 +385,33 @@ type ZSetZRankRequest struct {
 // ZSetZRevRankRequest 定义了ZREVRANK操作的请求体
 type ZSetZRevRankRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string `json:"key"`
 	Member string `json:"member"`
 }
 
 // ZSetZRangeRequest 定义了ZRANGE操作的请求体
+// 默认按排名区间(start/stop)查询；by_score/by_lex时使用min/max区间，并可配合offset/count分页
 type ZSetZRangeRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key        string `json:"key"`
 	Start      int64  `json:"start"`
 	Stop       int64  `json:"stop"`
 	WithScores bool   `json:"with_scores,omitempty"` // 是否返回分数
+	ByScore    bool   `json:"by_score,omitempty"`    // 按分数区间查询
+	ByLex      bool   `json:"by_lex,omitempty"`      // 按字典序区间查询
+	Rev        bool   `json:"rev,omitempty"`         // 逆序返回
+	Min        string `json:"min,omitempty"`         // by_score时支持 -inf, +inf, (value；by_lex时支持 -, +, [value, (value
+	Max        string `json:"max,omitempty"`         // 同min
+	Offset     int64  `json:"offset,omitempty"`      // LIMIT偏移量，仅by_score/by_lex时有效
+	Count      int64  `json:"count,omitempty"`       // LIMIT数量，仅by_score/by_lex时有效
 }
 
 // ZSetZRevRangeRequest 定义了ZREVRANGE操作的请求体
 type ZSetZRevRangeRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key        string `json:"key"`
 	Start      int64  `json:"start"`
 	Stop       int64  `json:"stop"`
@@ -225,6 +421,7 @@ type ZSetZRevRangeRequest struct {
 // ZSetZRangeByScoreRequest 定义了ZRANGEBYSCORE操作的请求体
 type ZSetZRangeByScoreRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key        string `json:"key"`
 	Min        string `json:"min"` // 支持 -inf, +inf, (value 等格式
 	Max        string `json:"max"` // 支持 -inf, +inf, (value 等格式
@@ -236,6 +433,7 @@ type ZSetZRangeByScoreRequest struct {
 // ZSetZRevRangeByScoreRequest 定义了ZREVRANGEBYSCORE操作的请求体
 type ZSetZRevRangeByScoreRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key        string `json:"key"`
 	Max        string `json:"max"` // 支持 -inf, +inf, (value 等格式
 	Min        string `json:"min"` // 支持 -inf, +inf, (value 等格式
@@ -247,6 +445,7 @@ type ZSetZRevRangeByScoreRequest struct {
 // ZSetZRemRequest 定义了ZREM操作的请求体
 type ZSetZRemRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key     string   `json:"key"`
 	Members []string `json:"members"`
 }
@@ -267,38 +466,169 @@ type ZSetZRemRangeByScoreRequest struct {
 	Max string `json:"max"` // 支持 -inf, +inf, (value 等格式
 }
 
+// ZSetZRangeByLexRequest 定义了ZRANGEBYLEX操作的请求体
+type ZSetZRangeByLexRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key    string `json:"key"`
+	Min    string `json:"min"` // 支持 -, +, [value, (value 等格式
+	Max    string `json:"max"` // 支持 -, +, [value, (value 等格式
+	Offset int64  `json:"offset,omitempty"`
+	Count  int64  `json:"count,omitempty"`
+}
+
+// ZSetZLexCountRequest 定义了ZLEXCOUNT操作的请求体
+type ZSetZLexCountRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key string `json:"key"`
+	Min string `json:"min"` // 支持 -, +, [value, (value 等格式
+	Max string `json:"max"` // 支持 -, +, [value, (value 等格式
+}
+
+// ZSetZRemRangeByLexRequest 定义了ZREMRANGEBYLEX操作的请求体
+type ZSetZRemRangeByLexRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key string `json:"key"`
+	Min string `json:"min"` // 支持 -, +, [value, (value 等格式
+	Max string `json:"max"` // 支持 -, +, [value, (value 等格式
+}
+
+// ZSetZPopMinRequest 定义了ZPOPMIN操作的请求体
+type ZSetZPopMinRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key   string `json:"key"`
+	Count int64  `json:"count,omitempty"` // 弹出数量，0表示弹出一个
+}
+
+// ZSetZPopMaxRequest 定义了ZPOPMAX操作的请求体
+type ZSetZPopMaxRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key   string `json:"key"`
+	Count int64  `json:"count,omitempty"` // 弹出数量，0表示弹出一个
+}
+
+// ZSetBZPopMinRequest 定义了BZPOPMIN操作的请求体
+type ZSetBZPopMinRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys    []string `json:"keys"`
+	Timeout float64  `json:"timeout"` // 阻塞超时时间，单位秒，必须大于0且短于请求超时
+}
+
+// ZSetZMScoreRequest 定义了ZMSCORE操作的请求体
+type ZSetZMScoreRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key     string   `json:"key"`
+	Members []string `json:"members"`
+}
+
+// ZSetZRandMemberRequest 定义了ZRANDMEMBER操作的请求体
+type ZSetZRandMemberRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key        string `json:"key"`
+	Count      int64  `json:"count,omitempty"`       // 返回数量，0表示单个成员，负数表示允许重复
+	WithScores bool   `json:"with_scores,omitempty"` // 是否返回分数
+}
+
+// ZSetZUnionRequest 定义了ZUNION操作的请求体
+type ZSetZUnionRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys       []string  `json:"keys"`
+	Weights    []float64 `json:"weights,omitempty"`   // 每个key的权重，数量需与keys一致
+	Aggregate  string    `json:"aggregate,omitempty"` // SUM, MIN, MAX
+	WithScores bool      `json:"with_scores,omitempty"`
+}
+
+// ZSetZUnionStoreRequest 定义了ZUNIONSTORE操作的请求体
+type ZSetZUnionStoreRequest struct {
+	RedisRequest
+	Destination string    `json:"destination"`
+	Keys        []string  `json:"keys"`
+	Weights     []float64 `json:"weights,omitempty"`   // 每个key的权重，数量需与keys一致
+	Aggregate   string    `json:"aggregate,omitempty"` // SUM, MIN, MAX
+}
+
+// ZSetZInterRequest 定义了ZINTER操作的请求体
+type ZSetZInterRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys       []string  `json:"keys"`
+	Weights    []float64 `json:"weights,omitempty"`   // 每个key的权重，数量需与keys一致
+	Aggregate  string    `json:"aggregate,omitempty"` // SUM, MIN, MAX
+	WithScores bool      `json:"with_scores,omitempty"`
+}
+
+// ZSetZInterStoreRequest 定义了ZINTERSTORE操作的请求体
+type ZSetZInterStoreRequest struct {
+	RedisRequest
+	Destination string    `json:"destination"`
+	Keys        []string  `json:"keys"`
+	Weights     []float64 `json:"weights,omitempty"`   // 每个key的权重，数量需与keys一致
+	Aggregate   string    `json:"aggregate,omitempty"` // SUM, MIN, MAX
+}
+
+// ZSetZDiffRequest 定义了ZDIFF操作的请求体
+type ZSetZDiffRequest struct {
+	RedisRequest
+	ValueEncoding
+	Keys       []string `json:"keys"` // 第一个key与其余key的差集
+	WithScores bool     `json:"with_scores,omitempty"`
+}
+
+// ZSetZDiffStoreRequest 定义了ZDIFFSTORE操作的请求体
+type ZSetZDiffStoreRequest struct {
+	RedisRequest
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+}
+
 // Hash操作请求类型
 
 // HashHSetRequest 定义了HSET操作的请求体
 type HashHSetRequest struct {
 	RedisRequest
-	Key    string            `json:"key"`
-	Fields map[string]string `json:"fields"` // field -> value 映射
+	ValueEncoding
+	Key    string           `json:"key"`
+	Fields map[string]Value `json:"fields" swaggertype:"object,string"` // field -> value 映射，encoding为json时value可以是任意JSON
 }
 
 // HashHGetRequest 定义了HGET操作的请求体
 type HashHGetRequest struct {
 	RedisRequest
-	Key   string `json:"key"`
-	Field string `json:"field"`
+	ValueEncoding
+	Key      string `json:"key"`
+	Field    string `json:"field"`
+	JSONPath string `json:"json_path,omitempty"` // encoding为json时只返回文档中的子字段，如$.user.tags[0]
 }
 
 // HashHMGetRequest 定义了HMGET操作的请求体
 type HashHMGetRequest struct {
 	RedisRequest
-	Key    string   `json:"key"`
-	Fields []string `json:"fields"`
+	ValueEncoding
+	Key      string   `json:"key"`
+	Fields   []string `json:"fields"`
+	JSONPath string   `json:"json_path,omitempty"` // encoding为json时只返回每个文档中的子字段
 }
 
 // HashHGetAllRequest 定义了HGETALL操作的请求体
 type HashHGetAllRequest struct {
 	RedisRequest
-	Key string `json:"key"`
+	ValueEncoding
+	Key      string `json:"key"`
+	JSONPath string `json:"json_path,omitempty"` // encoding为json时只返回每个文档中的子字段
 }
 
 // HashHDelRequest 定义了HDEL操作的请求体
 type HashHDelRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key    string   `json:"key"`
 	Fields []string `json:"fields"`
 }
@@ -306,6 +636,7 @@ type HashHDelRequest struct {
 // HashHExistsRequest 定义了HEXISTS操作的请求体
 type HashHExistsRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key   string `json:"key"`
 	Field string `json:"field"`
 }
@@ -319,19 +650,349 @@ type HashHLenRequest struct {
 // HashHKeysRequest 定义了HKEYS操作的请求体
 type HashHKeysRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key string `json:"key"`
 }
 
 // HashHValsRequest 定义了HVALS操作的请求体
 type HashHValsRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key string `json:"key"`
 }
 
 // HashHIncrByRequest 定义了HINCRBY操作的请求体
 type HashHIncrByRequest struct {
 	RedisRequest
+	ValueEncoding
 	Key       string `json:"key"`
 	Field     string `json:"field"`
 	Increment int64  `json:"increment"`
 }
+
+// HashHSetNXRequest 定义了HSETNX操作的请求体
+type HashHSetNXRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key   string `json:"key"`
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// HashHIncrByFloatRequest 定义了HINCRBYFLOAT操作的请求体
+type HashHIncrByFloatRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key       string  `json:"key"`
+	Field     string  `json:"field"`
+	Increment float64 `json:"increment"`
+}
+
+// HashHStrLenRequest 定义了HSTRLEN操作的请求体
+type HashHStrLenRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key   string `json:"key"`
+	Field string `json:"field"`
+}
+
+// HashHRandFieldRequest 定义了HRANDFIELD操作的请求体
+type HashHRandFieldRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key        string `json:"key"`
+	Count      int64  `json:"count,omitempty"`       // 返回数量，0表示单个字段，负数表示允许重复
+	WithValues bool   `json:"with_values,omitempty"` // 是否返回字段值
+}
+
+// HashHExpireRequest 定义了HEXPIRE操作的请求体
+type HashHExpireRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key       string   `json:"key"`
+	Fields    []string `json:"fields"`
+	TTL       int64    `json:"ttl"`                 // 过期时间，单位秒
+	Condition string   `json:"condition,omitempty"` // NX, XX, GT, LT
+}
+
+// HashHTTLRequest 定义了HTTL操作的请求体
+type HashHTTLRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key    string   `json:"key"`
+	Fields []string `json:"fields"`
+}
+
+// HashHPersistRequest 定义了HPERSIST操作的请求体
+type HashHPersistRequest struct {
+	RedisRequest
+	ValueEncoding
+	Key    string   `json:"key"`
+	Fields []string `json:"fields"`
+}
+
+// BitmapSetBitRequest 定义了SETBIT操作的请求体
+type BitmapSetBitRequest struct {
+	RedisRequest
+	Key    string `json:"key"`
+	Offset int64  `json:"offset"` // 位偏移
+	Value  int    `json:"value"`  // 位值，只能为0或1
+}
+
+// BitmapGetBitRequest 定义了GETBIT操作的请求体
+type BitmapGetBitRequest struct {
+	RedisRequest
+	Key    string `json:"key"`
+	Offset int64  `json:"offset"` // 位偏移
+}
+
+// BitmapBitCountRequest 定义了BITCOUNT操作的请求体
+type BitmapBitCountRequest struct {
+	RedisRequest
+	Key   string `json:"key"`
+	Start *int64 `json:"start,omitempty"` // 起始位置，需与end同时指定
+	End   *int64 `json:"end,omitempty"`   // 结束位置，需与start同时指定
+	Unit  string `json:"unit,omitempty"`  // 范围单位：BYTE（默认）或BIT（需要Redis 7.0+）
+}
+
+// BitmapBitPosRequest 定义了BITPOS操作的请求体
+type BitmapBitPosRequest struct {
+	RedisRequest
+	Key   string `json:"key"`
+	Bit   int    `json:"bit"`             // 要查找的位值，只能为0或1
+	Start *int64 `json:"start,omitempty"` // 起始位置
+	End   *int64 `json:"end,omitempty"`   // 结束位置，需同时指定start
+	Unit  string `json:"unit,omitempty"`  // 范围单位：BYTE（默认）或BIT（需要Redis 7.0+）
+}
+
+// BitmapBitOpRequest 定义了BITOP操作的请求体
+type BitmapBitOpRequest struct {
+	RedisRequest
+	Operation   string   `json:"operation"`   // 位运算：AND、OR、XOR、NOT
+	Destination string   `json:"destination"` // 结果键
+	Keys        []string `json:"keys"`        // 源键列表，NOT只能指定一个键
+}
+
+// BitFieldOperation 定义了BITFIELD的单个子操作
+type BitFieldOperation struct {
+	Op        string `json:"op"`                  // 子操作：GET、SET、INCRBY、OVERFLOW
+	Type      string `json:"type,omitempty"`      // 整数类型，如u8、i16（OVERFLOW不需要）
+	Offset    string `json:"offset,omitempty"`    // 位偏移，支持#N形式表示按类型宽度的倍数
+	Value     int64  `json:"value,omitempty"`     // SET写入的值
+	Increment int64  `json:"increment,omitempty"` // INCRBY的增量
+	Overflow  string `json:"overflow,omitempty"`  // OVERFLOW模式：WRAP、SAT、FAIL
+}
+
+// BitmapBitFieldRequest 定义了BITFIELD操作的请求体
+type BitmapBitFieldRequest struct {
+	RedisRequest
+	Key        string              `json:"key"`
+	Operations []BitFieldOperation `json:"operations"` // 按顺序执行的子操作
+}
+
+// BitmapBitFieldRORequest 定义了BITFIELD_RO操作的请求体
+type BitmapBitFieldRORequest struct {
+	RedisRequest
+	Key        string              `json:"key"`
+	Operations []BitFieldOperation `json:"operations"` // 只允许GET子操作
+}
+
+// HLLPFAddRequest 定义了PFADD操作的请求体
+type HLLPFAddRequest struct {
+	RedisRequest
+	Key      string   `json:"key"`
+	Elements []string `json:"elements"` // 要添加的元素，为空时仅创建键
+}
+
+// HLLPFCountRequest 定义了PFCOUNT操作的请求体
+type HLLPFCountRequest struct {
+	RedisRequest
+	Keys []string `json:"keys"` // 多个键时返回并集的近似基数
+}
+
+// HLLPFMergeRequest 定义了PFMERGE操作的请求体
+type HLLPFMergeRequest struct {
+	RedisRequest
+	Destination string   `json:"destination"` // 结果键
+	Keys        []string `json:"keys"`        // 源键列表
+}
+
+// GeoLocation 定义了GEOADD中的一个位置
+type GeoLocation struct {
+	Member    string  `json:"member"`
+	Longitude float64 `json:"longitude"` // 经度，范围-180到180
+	Latitude  float64 `json:"latitude"`  // 纬度，范围-85.05112878到85.05112878
+}
+
+// GeoAddFlags 定义了GEOADD的可选标志（需要Redis 6.2+）
+type GeoAddFlags struct {
+	NX bool `json:"nx,omitempty"` // 只添加新成员，不更新已有成员
+	XX bool `json:"xx,omitempty"` // 只更新已有成员，不添加新成员
+	CH bool `json:"ch,omitempty"` // 返回值包含被更新位置的成员数
+}
+
+// GeoAddRequest 定义了GEOADD操作的请求体
+type GeoAddRequest struct {
+	RedisRequest
+	GeoAddFlags
+	Key       string        `json:"key"`
+	Locations []GeoLocation `json:"locations"`
+}
+
+// GeoPosRequest 定义了GEOPOS操作的请求体
+type GeoPosRequest struct {
+	RedisRequest
+	Key     string   `json:"key"`
+	Members []string `json:"members"`
+}
+
+// GeoDistRequest 定义了GEODIST操作的请求体
+type GeoDistRequest struct {
+	RedisRequest
+	Key     string `json:"key"`
+	Member1 string `json:"member1"`
+	Member2 string `json:"member2"`
+	Unit    string `json:"unit,omitempty"` // 距离单位：m（默认）、km、ft、mi
+}
+
+// GeoHashRequest 定义了GEOHASH操作的请求体
+type GeoHashRequest struct {
+	RedisRequest
+	Key     string   `json:"key"`
+	Members []string `json:"members"`
+}
+
+// GeoSearchOptions 定义了GEOSEARCH和GEOSEARCHSTORE共用的搜索条件（需要Redis 6.2+）
+// 中心点二选一：member，或longitude与latitude；范围二选一：radius，或width与height
+type GeoSearchOptions struct {
+	Member    string   `json:"member,omitempty"`    // 以已有成员为中心（FROMMEMBER）
+	Longitude *float64 `json:"longitude,omitempty"` // 以经纬度为中心（FROMLONLAT）
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Radius    float64  `json:"radius,omitempty"` // 圆形范围半径（BYRADIUS）
+	Width     float64  `json:"width,omitempty"`  // 矩形范围宽度（BYBOX）
+	Height    float64  `json:"height,omitempty"` // 矩形范围高度（BYBOX）
+	Unit      string   `json:"unit,omitempty"`   // 距离单位：m（默认）、km、ft、mi
+	Sort      string   `json:"sort,omitempty"`   // 排序：ASC或DESC，为空时不排序
+	Count     int64    `json:"count,omitempty"`  // 最多返回的成员数，0表示不限制
+	Any       bool     `json:"any,omitempty"`    // 找到count个成员后立即返回，需指定count
+}
+
+// GeoSearchRequest 定义了GEOSEARCH操作的请求体
+type GeoSearchRequest struct {
+	RedisRequest
+	GeoSearchOptions
+	Key       string `json:"key"`
+	WithCoord bool   `json:"with_coord,omitempty"` // 返回成员的经纬度
+	WithDist  bool   `json:"with_dist,omitempty"`  // 返回成员到中心点的距离
+}
+
+// GeoSearchStoreRequest 定义了GEOSEARCHSTORE操作的请求体
+type GeoSearchStoreRequest struct {
+	RedisRequest
+	GeoSearchOptions
+	Destination string `json:"destination"`          // 结果键
+	Key         string `json:"key"`                  // 源键
+	StoreDist   bool   `json:"store_dist,omitempty"` // 以距离而非geohash作为结果的分数
+}
+
+// ScriptEvalRequest 定义了EVAL/EVAL_RO操作的请求体
+type ScriptEvalRequest struct {
+	RedisRequest
+	Script   string   `json:"script"`              // Lua脚本内容
+	Keys     []string `json:"keys,omitempty"`      // KEYS参数
+	Args     []string `json:"args,omitempty"`      // ARGV参数
+	ReadOnly bool     `json:"read_only,omitempty"` // 使用EVAL_RO执行（需要Redis 7.0+）
+}
+
+// ScriptEvalShaRequest 定义了EVALSHA/EVALSHA_RO操作的请求体
+type ScriptEvalShaRequest struct {
+	RedisRequest
+	SHA      string   `json:"sha"`                 // 脚本的SHA1摘要
+	Keys     []string `json:"keys,omitempty"`      // KEYS参数
+	Args     []string `json:"args,omitempty"`      // ARGV参数
+	ReadOnly bool     `json:"read_only,omitempty"` // 使用EVALSHA_RO执行（需要Redis 7.0+）
+}
+
+// ScriptRunRequest 定义了按名称执行已注册脚本的请求体
+type ScriptRunRequest struct {
+	RedisRequest
+	Name     string   `json:"name"`                // 注册的脚本名
+	Keys     []string `json:"keys,omitempty"`      // KEYS参数
+	Args     []string `json:"args,omitempty"`      // ARGV参数
+	ReadOnly bool     `json:"read_only,omitempty"` // 使用EVALSHA_RO执行（需要Redis 7.0+）
+}
+
+// ScriptLoadRequest 定义了SCRIPT LOAD操作的请求体
+type ScriptLoadRequest struct {
+	RedisRequest
+	Script string `json:"script"` // Lua脚本内容
+}
+
+// ScriptExistsRequest 定义了SCRIPT EXISTS操作的请求体
+type ScriptExistsRequest struct {
+	RedisRequest
+	SHAs []string `json:"shas"` // 脚本的SHA1摘要列表
+}
+
+// ScriptFlushRequest 定义了SCRIPT FLUSH操作的请求体
+type ScriptFlushRequest struct {
+	RedisRequest
+	Mode string `json:"mode,omitempty"` // 清空模式：ASYNC或SYNC（需要Redis 6.2+），为空时使用服务器默认值
+}
+
+// FunctionLoadRequest 定义了FUNCTION LOAD操作的请求体
+type FunctionLoadRequest struct {
+	RedisRequest
+	Code    string `json:"code"`              // 函数库源码，首行需为#!lua name=<library>
+	Replace bool   `json:"replace,omitempty"` // 替换同名函数库
+}
+
+// FunctionListRequest 定义了FUNCTION LIST操作的请求体
+type FunctionListRequest struct {
+	RedisRequest
+	LibraryName string `json:"library_name,omitempty"` // 函数库名匹配模式
+	WithCode    bool   `json:"with_code,omitempty"`    // 返回函数库源码
+}
+
+// FunctionDeleteRequest 定义了FUNCTION DELETE操作的请求体
+type FunctionDeleteRequest struct {
+	RedisRequest
+	LibraryName string `json:"library_name"`
+}
+
+// FunctionDumpRequest 定义了FUNCTION DUMP操作的请求体
+type FunctionDumpRequest struct {
+	RedisRequest
+}
+
+// FunctionRestoreRequest 定义了FUNCTION RESTORE操作的请求体
+type FunctionRestoreRequest struct {
+	RedisRequest
+	Payload string `json:"payload"`          // FUNCTION DUMP返回的base64编码数据
+	Policy  string `json:"policy,omitempty"` // 恢复策略：APPEND（默认）、REPLACE、FLUSH
+}
+
+// FunctionCallRequest 定义了FCALL操作的请求体
+type FunctionCallRequest struct {
+	RedisRequest
+	Function string   `json:"function"`       // 函数名
+	Keys     []string `json:"keys,omitempty"` // 键参数
+	Args     []string `json:"args,omitempty"` // 其他参数
+}
+
+// FunctionCallRORequest 定义了FCALL_RO操作的请求体
+type FunctionCallRORequest struct {
+	RedisRequest
+	Function   string   `json:"function"`              // 函数名，需声明no-writes标志
+	Keys       []string `json:"keys,omitempty"`        // 键参数
+	Args       []string `json:"args,omitempty"`        // 其他参数
+	UsePrimary bool     `json:"use_primary,omitempty"` // 即使配置了副本也发往主节点
+}
+
+// Pipeline请求类型
+
+// PipelineRequest 定义了Pipeline批量执行命令的请求体
+type PipelineRequest struct {
+	RedisRequest
+	Commands [][]string `json:"commands"` // 命令列表，每个命令为命令名加参数，如["SET","k","v"]
+}